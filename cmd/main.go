@@ -1,79 +1,640 @@
 package main
 
 import (
-	"go/ast"
-	"go/parser"
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"go/build"
 	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/krishicks/margarine"
+)
+
+const usage = `usage: margarine [flags] <package-dir-or-import-path> <InterfaceName>
+       margarine [flags] -all <package-dir-or-import-path>
+       margarine [flags] -scan <dir>
+       margarine generate [-manifest file]
+
+Generates a fake of the interface InterfaceName declared in the package and
+writes it to <pkg>fakes/fake_<interface_name>.go in the package's directory,
+or in the working directory for a package outside the current module, such as
+one in the standard library. With -package, the fake is written to a
+directory named after its package instead, or alongside the interface when
+it is in the interface's package.
+With -all, does the same for every exported interface in the package.
+
+With -scan, fakes every interface under dir that has a //margarine:fake
+directive in its doc comment, such as:
+
+	//margarine:fake name=FakeStore out=storefakes/store.go strict
+	type Store interface {
+
+The options, all optional, are name=<name>, out=<file> (relative to the
+package's directory), package=<name>, strict and deep-copy-args.
+
+margarine generate generates every fake listed in the margarine.json manifest
+at the root of the module.
+
+flags:
+`
+
+// exit codes
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
 )
 
 func main() {
-	// opts := patrick.Opts{
-	// 	StructName:         "FakeSimple",
-	// 	PreserveParamNames: false,
-	// }
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) > 0 && args[0] == "generate" {
+		return runGenerate(args[1:], stderr)
+	}
+
+	flags := flag.NewFlagSet("margarine", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	out := flags.String("o", "", "write the fake to `file`, or to stdout when it is -")
+	structName := flags.String("name", "", "`name` of the fake, Fake<InterfaceName> when not set")
+	pkgName := flags.String("package", "", "`name` of the fake's package, <pkg>fakes or the name of the directory of -o when not set")
+	strict := flags.Bool("strict", false, "fail calls to methods without a stub or return values")
+	deepCopyArgs := flags.Bool("deep-copy-args", false, "record deep copies of the args of calls")
+	tests := flags.Bool("tests", false, "look for the interface in _test.go files as well")
+	all := flags.Bool("all", false, "fake every exported interface in the package")
+	scan := flags.Bool("scan", false, "fake every interface with a //margarine:fake directive under a directory")
+
+	err := flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		return exitUsage
+	}
+
+	if *all && *scan {
+		fmt.Fprintln(stderr, "margarine: -all and -scan cannot be used together")
+		return exitUsage
+	}
+
+	if *all || *scan {
+		if flags.NArg() != 1 {
+			fmt.Fprintf(stderr, "margarine: expected a package or directory, got %d arguments\n\n", flags.NArg())
+			flags.Usage()
+			return exitUsage
+		}
+		if *out != "" || *structName != "" {
+			fmt.Fprintln(stderr, "margarine: -o and -name cannot be used with -all or -scan")
+			return exitUsage
+		}
+	} else if flags.NArg() != 2 {
+		fmt.Fprintf(stderr, "margarine: expected a package and an interface name, got %d arguments\n\n", flags.NArg())
+		flags.Usage()
+		return exitUsage
+	}
+
+	pkgArg, ifaceName := flags.Arg(0), flags.Arg(1)
+
+	if !*all && !*scan && !token.IsIdentifier(ifaceName) {
+		fmt.Fprintf(stderr, "margarine: %q is not a valid interface name\n", ifaceName)
+		return exitUsage
+	}
+
+	findOpts := margarine.FindOpts{IncludeTests: *tests}
+
+	opts := margarine.GenerateOpts{
+		FakifyOpts: margarine.FakifyOpts{
+			StructName:   *structName,
+			Strict:       *strict,
+			DeepCopyArgs: *deepCopyArgs,
+		},
+		PackageName: *pkgName,
+	}
+
+	switch {
+	case *all:
+		err = generateAll(pkgArg, stderr, findOpts, opts)
+	case *scan:
+		err = generateScanned(pkgArg, opts)
+	default:
+		err = generate(pkgArg, ifaceName, *out, stdout, findOpts, opts)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "margarine: %s\n", err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+const generateUsage = `usage: margarine generate [-manifest file]
+
+Generates every fake listed in the margarine.json manifest at the root of the
+module the current directory is in, which looks like:
+
+	{
+	  "fakes": [
+	    {
+	      "package": "example.com/service/store",
+	      "interface": "Store",
+	      "outDir": "store/storefakes",
+	      "name": "FakeStore",
+	      "packageName": "storefakes",
+	      "strict": true,
+	      "deepCopyArgs": true,
+	      "includeTests": false
+	    }
+	  ]
+	}
+
+Only package and interface are required. outDir is relative to the manifest,
+and is required for packages outside the module.
+
+flags:
+`
+
+func runGenerate(args []string, stderr io.Writer) int {
+	flags := flag.NewFlagSet("margarine generate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, generateUsage)
+		flags.PrintDefaults()
+	}
+
+	manifestPath := flags.String("manifest", "", "read the manifest from `file` rather than the module's margarine.json")
+
+	err := flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		return exitUsage
+	}
+
+	if flags.NArg() != 0 {
+		fmt.Fprintf(stderr, "margarine: expected no arguments, got %d\n\n", flags.NArg())
+		flags.Usage()
+		return exitUsage
+	}
 
-	// src, err := ioutil.ReadFile("notes.go")
-	// if err != nil {
-	// 	log.Fatal(err.Error())
-	// }
+	err = generateManifest(*manifestPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "margarine: %s\n", err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+func generateManifest(path string) error {
+	if path == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+
+		root, _, err := findModule(wd)
+		if err != nil {
+			return fmt.Errorf("unable to find %s: %s", margarine.ManifestFile, err)
+		}
+
+		path = filepath.Join(root, margarine.ManifestFile)
+	}
+
+	manifest, err := margarine.ReadManifest(path)
+	if err != nil {
+		return err
+	}
+
+	root, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
 
-	// genDecl, funcDecls, err := patrick.Pour(src, "Simple", opts)
-	// if err != nil {
-	// 	log.Fatal(err.Error())
-	// }
+	for _, fake := range manifest.Fakes {
+		pkgArg := manifestPackage(root, fake.Package)
 
-	// decls := []ast.Decl{genDecl}
-	// for _, fd := range funcDecls {
-	// 	decls = append(decls, fd)
-	// }
+		var out string
+		if fake.OutDir != "" {
+			out = filepath.Join(root, filepath.FromSlash(fake.OutDir), "fake_"+snakeCase(fake.Interface)+".go")
+		} else if !filepath.IsAbs(pkgArg) {
+			// the default, the working directory, would depend on where
+			// margarine generate is run
+			return fmt.Errorf("%s: outDir is required for a package outside the module", fake.Package)
+		}
 
-	// f := &ast.File{
-	// 	Name: &ast.Ident{
-	// 		Name: "mypackage",
-	// 	},
-	// 	Decls: decls,
-	// }
+		findOpts := margarine.FindOpts{IncludeTests: fake.IncludeTests}
 
-	// fset := token.NewFileSet()
-	// ast.Print(fset, f)
+		err := generate(pkgArg, fake.Interface, out, nil, findOpts, margarine.GenerateOpts{
+			FakifyOpts: margarine.FakifyOpts{
+				StructName:   fake.Name,
+				Strict:       fake.Strict,
+				DeepCopyArgs: fake.DeepCopyArgs,
+			},
+			PackageName: fake.PackageName,
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", fake.Package, err)
+		}
+	}
 
-	// margarine.Fakify(f, margarine.FakifyOpts{StructName: "FakeSimple"})
+	return nil
+}
 
-	// -----------------
+// manifestPackage returns the directory of a package of the module whose
+// manifest is in root, so that it is found without asking the go tool, and
+// the import path of any other package.
+func manifestPackage(root string, pkgPath string) string {
+	modulePath, err := modulePath(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return pkgPath
+	}
 
-	src := `
-	package somepackage
+	if pkgPath == modulePath {
+		return root
+	}
 
-	type SomeStruct struct {
-		ScanStub  func(int) bool
+	if rel := strings.TrimPrefix(pkgPath, modulePath+"/"); rel != pkgPath {
+		return filepath.Join(root, filepath.FromSlash(rel))
 	}
 
-	func (s SomeStruct) A() int {
-		var a int
-		return a
+	return pkgPath
+}
+
+func generate(pkgArg string, ifaceName string, out string, stdout io.Writer, findOpts margarine.FindOpts, opts margarine.GenerateOpts) error {
+	pkg, fakesDir, err := importPackage(pkgArg)
+	if err != nil {
+		return err
 	}
-	`
 
 	fset := token.NewFileSet()
-	f, err := parser.ParseFile(fset, "src.go", src, parser.ParseComments)
+
+	found, err := margarine.FindInterface(fset, pkg.Dir, ifaceName, findOpts)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	ast.Print(fset, f)
-	// -----------------
-	// fset := token.NewFileSet()
+	pkgName := found.File.Name.Name
+	if opts.PackageName == "" {
+		opts.PackageName, err = outPackage(pkg.Dir, pkgName, out)
+	} else if out != "" && out != "-" {
+		err = checkOutPackage(pkg.Dir, pkgName, opts.PackageName, out)
+	}
+	if err != nil {
+		return err
+	}
 
-	// var buf bytes.Buffer
-	// err = format.Node(&buf, fset, f)
-	// if err != nil {
-	// 	panic(err)
-	// }
+	if opts.PackageName != pkgName {
+		opts.ImportPath, err = importPath(pkg)
+		if err != nil {
+			return err
+		}
+	}
+
+	src, err := margarine.Generate(fset, found.Files, found.File, found.TypeSpec, opts)
+	if err != nil {
+		return err
+	}
+
+	if out == "-" {
+		_, err = stdout.Write(src)
+		return err
+	}
+
+	if out == "" {
+		out, err = fakePath(fakesDir, pkg.Dir, pkgName, opts.PackageName, ifaceName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeFake(out, src)
+}
+
+func generateAll(pkgArg string, stderr io.Writer, findOpts margarine.FindOpts, opts margarine.GenerateOpts) error {
+	pkg, fakesDir, err := importPackage(pkgArg)
+	if err != nil {
+		return err
+	}
 
-	// out, err := imports.Process("src.go", buf.Bytes(), nil)
-	// if err != nil {
-	// 	panic(err)
-	// }
+	// -all is for a package's own interfaces, which are all in the package
+	// named by its non-test files
+	pkgName := pkg.Name
+	if pkgName == "" {
+		return fmt.Errorf("no Go files in %s", pkg.Dir)
+	}
+
+	if opts.PackageName == "" {
+		opts.PackageName = pkgName + "fakes"
+	}
+
+	if opts.PackageName != pkgName {
+		opts.ImportPath, err = importPath(pkg)
+		if err != nil {
+			return err
+		}
+	}
+
+	fakes, skipped, err := margarine.GenerateAll(token.NewFileSet(), pkg.Dir, findOpts, opts)
+	if err != nil {
+		return err
+	}
+
+	for _, err := range skipped {
+		fmt.Fprintf(stderr, "margarine: warning: skipping %s\n", err)
+	}
+
+	if len(fakes) == 0 {
+		return fmt.Errorf("no exported interfaces in %s could be faked", pkg.Dir)
+	}
+
+	for _, fake := range fakes {
+		out, err := fakePath(fakesDir, pkg.Dir, pkgName, opts.PackageName, fake.Interface)
+		if err != nil {
+			return err
+		}
+
+		err = writeFake(out, fake.Src)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func generateScanned(root string, opts margarine.GenerateOpts) error {
+	directives, err := margarine.Scan(token.NewFileSet(), root)
+	if err != nil {
+		return err
+	}
+
+	if len(directives) == 0 {
+		return fmt.Errorf("no //margarine:fake directives found in %s", root)
+	}
+
+	for _, directive := range directives {
+		directiveOpts := opts
+		directiveOpts.StructName = directive.Name
+		directiveOpts.Strict = opts.Strict || directive.Strict
+		directiveOpts.DeepCopyArgs = opts.DeepCopyArgs || directive.DeepCopyArgs
+		if directive.Package != "" {
+			directiveOpts.PackageName = directive.Package
+		}
+
+		var out string
+		if directive.Out != "" {
+			out = filepath.Join(directive.Dir, directive.Out)
+		}
+
+		err := generate(directive.Dir, directive.Interface, out, nil, margarine.FindOpts{}, directiveOpts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// outPackage returns the package of a fake of an interface in package
+// pkgName in dir that is written to out. A fake written alongside the
+// interface is in its package, and one written elsewhere is in the package
+// named after the directory it is written to.
+func outPackage(dir string, pkgName string, out string) (string, error) {
+	if out == "" || out == "-" {
+		return pkgName + "fakes", nil
+	}
+
+	outDir, err := filepath.Abs(filepath.Dir(out))
+	if err != nil {
+		return "", err
+	}
+
+	if outDir == dir {
+		return pkgName, nil
+	}
+
+	name := filepath.Base(outDir)
+	if !token.IsIdentifier(name) {
+		return "", fmt.Errorf("unable to name the package of %s after its directory, so its package name must be given", out)
+	}
+
+	return name, nil
+}
+
+// checkOutPackage returns an error when a fake in package fakePkg of an
+// interface in package pkgName in dir cannot be written to out, because out
+// would have two packages in one directory, or because a fake in the
+// interface's own package is not written alongside it.
+func checkOutPackage(dir string, pkgName string, fakePkg string, out string) error {
+	outDir, err := filepath.Abs(filepath.Dir(out))
+	if err != nil {
+		return err
+	}
+
+	if outDir == dir && fakePkg != pkgName {
+		return fmt.Errorf("%s is in the directory of package %s, so the fake must be in package %s rather than %s", out, pkgName, pkgName, fakePkg)
+	}
+
+	if outDir != dir && fakePkg == pkgName {
+		return fmt.Errorf("a fake in package %s must be written to %s, alongside the interface", pkgName, dir)
+	}
+
+	return nil
+}
+
+// fakePath returns the default path of the fake in package fakePkg of iface,
+// which is declared in package pkgName in dir. A fake in the interface's own
+// package is written alongside it, and one in any other package to a
+// directory named after that package in fakesDir.
+func fakePath(fakesDir string, dir string, pkgName string, fakePkg string, iface string) (string, error) {
+	filename := "fake_" + snakeCase(iface) + ".go"
+
+	if fakePkg != pkgName {
+		return filepath.Join(fakesDir, fakePkg, filename), nil
+	}
+
+	if fakesDir != dir {
+		return "", fmt.Errorf("a fake in package %s would have to be written alongside the interface in %s, which is outside the current module", pkgName, dir)
+	}
+
+	return filepath.Join(dir, filename), nil
+}
+
+func writeFake(path string, src []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, src, 0644)
+}
+
+// importPackage finds the package at pkgArg, which is either a directory or
+// an import path. It also returns the directory the package's fakes are
+// written to by default, which is the package's own directory unless it was
+// found by import path outside the current module, such as in GOROOT or the
+// module cache, when it is the working directory.
+func importPackage(pkgArg string) (*build.Package, string, error) {
+	var pkg *build.Package
+	var err error
+
+	byImportPath := false
+	if info, statErr := os.Stat(pkgArg); statErr == nil && info.IsDir() {
+		pkg, err = build.ImportDir(pkgArg, 0)
+	} else if build.IsLocalImport(pkgArg) || filepath.IsAbs(pkgArg) {
+		return nil, "", fmt.Errorf("%s is not a directory", pkgArg)
+	} else {
+		byImportPath = true
+
+		var wd string
+		wd, err = os.Getwd()
+		if err != nil {
+			return nil, "", err
+		}
+		pkg, err = build.Import(pkgArg, wd, 0)
+	}
+
+	// a directory with only test files or files for other platforms is left
+	// to FindInterface
+	var noGoErr *build.NoGoError
+	if err != nil && !errors.As(err, &noGoErr) {
+		return nil, "", err
+	}
+
+	pkg.Dir, err = filepath.Abs(pkg.Dir)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !byImportPath {
+		return pkg, pkg.Dir, nil
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if pkg.Goroot || !sameModule(pkg.Dir, wd) {
+		return pkg, wd, nil
+	}
+
+	return pkg, pkg.Dir, nil
+}
+
+// sameModule reports whether dir is in the module the working directory wd
+// is in, or in GOPATH mode, where there is no module, whether it is anywhere.
+func sameModule(dir string, wd string) bool {
+	wdRoot, _, err := findModule(wd)
+	if err != nil {
+		return true
+	}
+
+	root, _, err := findModule(dir)
+	if err != nil {
+		return false
+	}
+
+	return root == wdRoot
+}
+
+// importPath returns the import path of pkg. Packages found by directory
+// outside of GOPATH have their import path worked out from the go.mod of the
+// module they are in.
+func importPath(pkg *build.Package) (string, error) {
+	if pkg.ImportPath != "" && !build.IsLocalImport(pkg.ImportPath) {
+		return pkg.ImportPath, nil
+	}
+
+	root, modulePath, err := findModule(pkg.Dir)
+	if err != nil {
+		return "", fmt.Errorf("unable to find the import path of %s, which is not in GOPATH or a module: %s", pkg.Dir, err)
+	}
+
+	rel, err := filepath.Rel(root, pkg.Dir)
+	if err != nil {
+		return "", err
+	}
+
+	if rel == "." {
+		return modulePath, nil
+	}
+
+	return modulePath + "/" + filepath.ToSlash(rel), nil
+}
+
+// findModule returns the root directory and path of the module dir is in.
+func findModule(dir string) (string, string, error) {
+	start := dir
+	for {
+		modulePath, err := modulePath(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			return dir, modulePath, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", "", err
+		}
+
+		if filepath.Dir(dir) == dir {
+			return "", "", fmt.Errorf("no go.mod in %s or any directory above it", start)
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+func modulePath(goMod string) (string, error) {
+	f, err := os.Open(goMod)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			if unquoted, err := strconv.Unquote(fields[1]); err == nil {
+				return unquoted, nil
+			}
+			return fields[1], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("%s has no module directive", goMod)
+}
+
+// snakeCase turns MyHTTPClient into my_http_client.
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && nextIsLower {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
 
-	// fmt.Printf(string(out))
+	return b.String()
 }
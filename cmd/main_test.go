@@ -0,0 +1,386 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/krishicks/margarine/internal/testfiles"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("run", func() {
+	var (
+		root   string
+		wd     string
+		stdout *bytes.Buffer
+		stderr *bytes.Buffer
+	)
+
+	var readFile = func(name string) string {
+		src, err := os.ReadFile(filepath.Join(root, name))
+		Expect(err).NotTo(HaveOccurred())
+		return string(src)
+	}
+
+	var exists = func(name string) bool {
+		_, err := os.Stat(filepath.Join(root, name))
+		return err == nil
+	}
+
+	var runArgs = func(args ...string) int {
+		return run(args, stdout, stderr)
+	}
+
+	BeforeEach(func() {
+		var err error
+		wd, err = os.Getwd()
+		Expect(err).NotTo(HaveOccurred())
+
+		root = testfiles.TempDir()
+
+		testfiles.WriteFile(root, "go.mod", "module example.com/mymodule\n\ngo 1.18\n")
+		testfiles.WriteFile(root, "store/store.go", `package store
+
+type Store interface {
+	Get(key string) (Thing, error)
+}
+
+type Thing struct{}
+
+type Number interface {
+	~int
+}
+
+type Private interface {
+	private()
+}
+`)
+
+		err = os.Chdir(root)
+		Expect(err).NotTo(HaveOccurred())
+
+		stdout = &bytes.Buffer{}
+		stderr = &bytes.Buffer{}
+	})
+
+	AfterEach(func() {
+		os.Chdir(wd)
+		os.RemoveAll(root)
+	})
+
+	Context("when given a package directory and an interface", func() {
+		It("writes the fake to the fakes directory of the package", func() {
+			Expect(runArgs("./store", "Store")).To(Equal(exitOK))
+			Expect(stderr.String()).To(BeEmpty())
+
+			fake := readFile("store/storefakes/fake_store.go")
+			Expect(fake).To(ContainSubstring("package storefakes\n"))
+			Expect(fake).To(ContainSubstring("\t\"example.com/mymodule/store\"\n"))
+			Expect(fake).To(ContainSubstring("var _ store.Store = new(FakeStore)"))
+		})
+
+		It("writes the fake to stdout with -o -", func() {
+			Expect(runArgs("-o", "-", "./store", "Store")).To(Equal(exitOK))
+
+			Expect(stdout.String()).To(HavePrefix("// Code generated by margarine. DO NOT EDIT.\n\npackage storefakes\n"))
+			Expect(exists("store/storefakes")).To(BeFalse())
+		})
+
+		It("writes the fake to -o, in the package named after its directory", func() {
+			Expect(runArgs("-o", "store/mocks/store.go", "./store", "Store")).To(Equal(exitOK))
+			Expect(readFile("store/mocks/store.go")).To(ContainSubstring("package mocks\n"))
+		})
+
+		It("writes the fake alongside the interface with -o in the package's directory", func() {
+			Expect(runArgs("-o", "store/fake.go", "./store", "Store")).To(Equal(exitOK))
+
+			fake := readFile("store/fake.go")
+			Expect(fake).To(ContainSubstring("package store\n"))
+			Expect(fake).To(ContainSubstring("var _ Store = new(FakeStore)"))
+		})
+
+		It("writes the fake alongside the interface with -package naming the interface's package", func() {
+			Expect(runArgs("-package", "store", "./store", "Store")).To(Equal(exitOK))
+			Expect(readFile("store/fake_store.go")).To(ContainSubstring("var _ Store = new(FakeStore)"))
+		})
+
+		It("writes the fake to a directory named after the package with -package", func() {
+			Expect(runArgs("-package", "mocks", "./store", "Store")).To(Equal(exitOK))
+			Expect(readFile("store/mocks/fake_store.go")).To(ContainSubstring("package mocks\n"))
+		})
+
+		It("fails when -o and -package would put two packages in one directory", func() {
+			Expect(runArgs("-o", "store/fake.go", "-package", "mocks", "./store", "Store")).To(Equal(exitError))
+			Expect(stderr.String()).To(Equal("margarine: store/fake.go is in the directory of package store, so the fake must be in package store rather than mocks\n"))
+		})
+
+		It("fails when the interface is not found, listing those that are", func() {
+			Expect(runArgs("./store", "Missing")).To(Equal(exitError))
+			Expect(stderr.String()).To(Equal("margarine: Missing: not found in " + filepath.Join(root, "store") + ", which declares Number, Private, Store\n"))
+		})
+
+		It("fails when the interface cannot be faked", func() {
+			Expect(runArgs("./store", "Private")).To(Equal(exitError))
+			Expect(stderr.String()).To(ContainSubstring("Private: unsupported unexported method private"))
+			Expect(exists("store/storefakes")).To(BeFalse())
+		})
+
+		It("fails when the directory does not exist", func() {
+			Expect(runArgs("./missing", "Store")).To(Equal(exitError))
+			Expect(stderr.String()).To(Equal("margarine: ./missing is not a directory\n"))
+		})
+	})
+
+	Context("when given an import path", func() {
+		It("writes the fake of a package in the module alongside it", func() {
+			Expect(runArgs("example.com/mymodule/store", "Store")).To(Equal(exitOK))
+			Expect(exists("store/storefakes/fake_store.go")).To(BeTrue())
+		})
+
+		It("writes the fake of a package outside the module to the working directory", func() {
+			Expect(runArgs("io", "Writer")).To(Equal(exitOK))
+
+			fake := readFile("iofakes/fake_writer.go")
+			Expect(fake).To(ContainSubstring("package iofakes\n"))
+			Expect(fake).To(ContainSubstring("var _ io.Writer = new(FakeWriter)"))
+		})
+
+		It("fails to write a fake into a package outside the module", func() {
+			Expect(runArgs("-package", "io", "io", "Writer")).To(Equal(exitError))
+			Expect(stderr.String()).To(ContainSubstring("which is outside the current module"))
+		})
+	})
+
+	Context("with -all", func() {
+		It("fakes each exported interface, warning about those it skips", func() {
+			Expect(runArgs("-all", "./store")).To(Equal(exitOK))
+
+			Expect(exists("store/storefakes/fake_store.go")).To(BeTrue())
+			Expect(exists("store/storefakes/fake_number.go")).To(BeFalse())
+			Expect(exists("store/storefakes/fake_private.go")).To(BeFalse())
+
+			Expect(stderr.String()).To(ContainSubstring("margarine: warning: skipping " + filepath.Join(root, "store", "store.go") + ":10:2: Number: unsupported"))
+			Expect(stderr.String()).To(ContainSubstring("margarine: warning: skipping " + filepath.Join(root, "store", "store.go") + ":14:2: Private: unsupported"))
+		})
+	})
+
+	Context("with -scan", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(root, "cache/cache.go", `package cache
+
+//margarine:fake name=MockCache package=mocks
+type Cache interface {
+	Put(key string)
+}
+`)
+		})
+
+		It("fakes each interface with a directive", func() {
+			Expect(runArgs("-scan", ".")).To(Equal(exitOK))
+
+			fake := readFile("cache/mocks/fake_cache.go")
+			Expect(fake).To(ContainSubstring("package mocks\n"))
+			Expect(fake).To(ContainSubstring("var _ cache.Cache = new(MockCache)"))
+		})
+	})
+
+	Context("with generate", func() {
+		It("fakes each interface in the manifest", func() {
+			testfiles.WriteFile(root, "margarine.json", `{
+  "fakes": [
+    {"package": "example.com/mymodule/store", "interface": "Store", "name": "StoreSpy"},
+    {"package": "io", "interface": "Reader", "outDir": "iofakes"}
+  ]
+}`)
+
+			err := os.Chdir(filepath.Join(root, "store"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(runArgs("generate")).To(Equal(exitOK))
+			Expect(stderr.String()).To(BeEmpty())
+
+			Expect(readFile("store/storefakes/fake_store.go")).To(ContainSubstring("var _ store.Store = new(StoreSpy)"))
+			Expect(readFile("iofakes/fake_reader.go")).To(ContainSubstring("var _ io.Reader = new(FakeReader)"))
+		})
+
+		It("fails for a package outside the module without an outDir", func() {
+			testfiles.WriteFile(root, "margarine.json", `{"fakes": [{"package": "io", "interface": "Reader"}]}`)
+
+			Expect(runArgs("generate")).To(Equal(exitError))
+			Expect(stderr.String()).To(Equal("margarine: io: outDir is required for a package outside the module\n"))
+		})
+
+		It("fails without a manifest", func() {
+			Expect(runArgs("generate")).To(Equal(exitError))
+			Expect(stderr.String()).To(ContainSubstring("margarine.json: no such file or directory"))
+		})
+
+		It("fails with arguments", func() {
+			Expect(runArgs("generate", "extra")).To(Equal(exitUsage))
+			Expect(stderr.String()).To(HavePrefix("margarine: expected no arguments, got 1\n"))
+		})
+	})
+
+	DescribeTable("exits with a usage error",
+		func(message string, args ...string) {
+			Expect(runArgs(args...)).To(Equal(exitUsage))
+			Expect(stderr.String()).To(HavePrefix(message))
+		},
+		Entry("without arguments", "margarine: expected a package and an interface name, got 0 arguments\n"),
+		Entry("with an invalid interface name", "margarine: \"not-valid\" is not a valid interface name\n", "./store", "not-valid"),
+		Entry("with an unknown flag", "flag provided but not defined: -nope\n", "-nope", "./store", "Store"),
+		Entry("with -o and -all", "margarine: -o and -name cannot be used with -all or -scan\n", "-all", "-o", "x.go", "./store"),
+		Entry("with -all and -scan", "margarine: -all and -scan cannot be used together\n", "-all", "-scan", "."),
+		Entry("with -all and an interface", "margarine: expected a package or directory, got 2 arguments\n", "-all", "./store", "Store"),
+	)
+
+	It("exits successfully with -h", func() {
+		Expect(runArgs("-h")).To(Equal(exitOK))
+		Expect(stderr.String()).To(HavePrefix("usage: margarine [flags]"))
+	})
+})
+
+var _ = Describe("findModule", func() {
+	It("returns the root and path of the module a directory is in", func() {
+		wd, err := os.Getwd()
+		Expect(err).NotTo(HaveOccurred())
+
+		root := testfiles.TempDir()
+		defer os.RemoveAll(root)
+
+		testfiles.WriteFile(root, "go.mod", "module \"example.com/quoted\"\n")
+		testfiles.WriteFile(root, "a/b/b.go", "package b\n")
+
+		moduleRoot, modulePath, err := findModule(filepath.Join(root, "a", "b"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(moduleRoot).To(Equal(root))
+		Expect(modulePath).To(Equal("example.com/quoted"))
+
+		Expect(os.Getwd()).To(Equal(wd))
+	})
+})
+
+var _ = Describe("manifestPackage", func() {
+	var root string
+
+	BeforeEach(func() {
+		root = testfiles.TempDir()
+		testfiles.WriteFile(root, "go.mod", "module example.com/mymodule\n")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(root)
+	})
+
+	It("returns the directory of a package of the module", func() {
+		Expect(manifestPackage(root, "example.com/mymodule")).To(Equal(root))
+		Expect(manifestPackage(root, "example.com/mymodule/a/b")).To(Equal(filepath.Join(root, "a", "b")))
+	})
+
+	It("returns the import path of any other package", func() {
+		Expect(manifestPackage(root, "io")).To(Equal("io"))
+		Expect(manifestPackage(root, "example.com/mymodulex")).To(Equal("example.com/mymodulex"))
+	})
+})
+
+var _ = Describe("outPackage", func() {
+	DescribeTable("names the package of a fake after where it is written",
+		func(out string, expected string) {
+			Expect(outPackage("/src/store", "store", out)).To(Equal(expected))
+		},
+		Entry("by default", "", "storefakes"),
+		Entry("to stdout", "-", "storefakes"),
+		Entry("alongside the interface", "/src/store/fake.go", "store"),
+		Entry("elsewhere", "/src/store/mocks/fake.go", "mocks"),
+	)
+
+	It("fails when the directory is not a valid package name", func() {
+		_, err := outPackage("/src/store", "store", "/src/store/my-mocks/fake.go")
+		Expect(err).To(MatchError("unable to name the package of /src/store/my-mocks/fake.go after its directory, so its package name must be given"))
+	})
+})
+
+var _ = DescribeTable("snakeCase",
+	func(name string, expected string) {
+		Expect(snakeCase(name)).To(Equal(expected))
+	},
+	Entry("a single word", "Store", "store"),
+	Entry("words", "ReadCloser", "read_closer"),
+	Entry("an initialism", "MyHTTPClient", "my_http_client"),
+	Entry("only an initialism", "HTTP", "http"),
+	Entry("initialisms next to each other", "JSONToYAML", "json_to_yaml"),
+	Entry("a digit", "Store2Go", "store2_go"),
+	Entry("unexported", "store", "store"),
+)
+
+var _ = Describe("the fakes of the fixtures", func() {
+	const directive = "//go:generate go run github.com/krishicks/margarine/cmd "
+
+	var wd string
+
+	BeforeEach(func() {
+		var err error
+		wd, err = os.Getwd()
+		Expect(err).NotTo(HaveOccurred())
+
+		// go generate runs the directives in the directory of their file
+		err = os.Chdir(filepath.Join("..", "fixtures"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.Chdir(wd)
+	})
+
+	It("are those their go:generate directives generate", func() {
+		filenames, err := filepath.Glob("*.go")
+		Expect(err).NotTo(HaveOccurred())
+
+		var generated int
+		for _, filename := range filenames {
+			src, err := os.ReadFile(filename)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, line := range strings.Split(string(src), "\n") {
+				if !strings.HasPrefix(line, directive) {
+					continue
+				}
+
+				// the fake is written to stdout instead of -o, in the
+				// package it would be written to
+				var args []string
+				var out string
+				fields := strings.Fields(strings.TrimPrefix(line, directive))
+				for i := 0; i < len(fields); i++ {
+					if fields[i] == "-o" && i+1 < len(fields) {
+						out = fields[i+1]
+						i++
+						continue
+					}
+					args = append(args, fields[i])
+				}
+				if out == "" {
+					out = filepath.Join("fixturesfakes", "fake_"+snakeCase(fields[len(fields)-1])+".go")
+				}
+				args = append([]string{"-o", "-", "-package", filepath.Base(filepath.Dir(out))}, args...)
+
+				stdout := &bytes.Buffer{}
+				stderr := &bytes.Buffer{}
+				Expect(run(args, stdout, stderr)).To(Equal(exitOK), line)
+				Expect(stderr.String()).To(BeEmpty())
+
+				expected, err := os.ReadFile(out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stdout.String()).To(Equal(string(expected)), out+" is out of date, run go generate in fixtures")
+
+				generated++
+			}
+		}
+
+		Expect(generated).NotTo(BeZero())
+	})
+})
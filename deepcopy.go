@@ -0,0 +1,518 @@
+package margarine
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// sharedTypes are the predeclared types whose args deepCopy would return as
+// they are: values that hold no memory the caller can change later, and
+// interfaces, whose values are shared.
+var sharedTypes = map[string]bool{
+	"any": true, "bool": true, "byte": true, "complex64": true,
+	"complex128": true, "error": true, "float32": true, "float64": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"rune": true, "string": true, "uint": true, "uint8": true, "uint16": true,
+	"uint32": true, "uint64": true, "uintptr": true,
+}
+
+// needsDeepCopy reports whether args of argType are recorded through
+// deepCopy when FakifyOpts.DeepCopyArgs is set. Which types hold maps,
+// slices or pointers is only known for those spelled out in the signature,
+// so named types, such as net.IP or type parameters, are always passed to
+// deepCopy.
+func needsDeepCopy(argType ast.Expr) bool {
+	switch t := argType.(type) {
+	case *ast.Ident:
+		return !sharedTypes[t.Name]
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return needsDeepCopy(t.Elt)
+		}
+	case *ast.InterfaceType, *ast.FuncType, *ast.ChanType:
+		return false
+	}
+
+	return true
+}
+
+// copiesArgs reports whether the fake of funcDecls has the deepCopy method.
+func copiesArgs(funcDecls []*ast.FuncDecl, opts FakifyOpts) bool {
+	if !opts.DeepCopyArgs {
+		return false
+	}
+
+	for _, funcDecl := range funcDecls {
+		for _, paramType := range fieldTypes(funcDecl.Type.Params) {
+			if needsDeepCopy(paramType) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// deepCopyArg returns the statement that declares copyName as a deep copy of
+// the arg argName:
+//
+//	argCopy := *fake.deepCopy(&arg).(*T)
+//
+// Passing a pointer to the arg has it copied as its own type, even when that
+// is an interface.
+func deepCopyArg(recv receiver, copyName string, argName string, argType ast.Expr) ast.Stmt {
+	if ellipsis, ok := argType.(*ast.Ellipsis); ok {
+		argType = &ast.ArrayType{Elt: ellipsis.Elt}
+	}
+
+	return define(ast.NewIdent(copyName), &ast.StarExpr{
+		X: &ast.TypeAssertExpr{
+			X:    callExpr(recv.field("deepCopy"), &ast.UnaryExpr{Op: token.AND, X: ast.NewIdent(argName)}),
+			Type: &ast.StarExpr{X: argType},
+		},
+	})
+}
+
+// addDeepCopyMethod adds the method that copies the args a fake records when
+// FakifyOpts.DeepCopyArgs is set, which returns a pointer to a deep copy of
+// what arg points to:
+//
+//	func (fake *FakeMyStruct) deepCopy(arg interface{}) interface{} {
+//		copies := map[uintptr]reflect.Value{}
+//		var holdsLock func(valueType reflect.Type) bool
+//		holdsLock = func(valueType reflect.Type) bool {
+//			if reflect.PtrTo(valueType).Implements(reflect.TypeOf((*sync.Locker)(nil)).Elem()) {
+//				return true
+//			}
+//			...
+//		}
+//		var copyValue func(value reflect.Value) reflect.Value
+//		copyValue = func(value reflect.Value) reflect.Value {
+//			switch value.Kind() {
+//			case reflect.Ptr:
+//				if value.IsNil() || holdsLock(value.Type().Elem()) {
+//					return value
+//				}
+//				if seen, found := copies[value.Pointer()]; found && seen.Type() == value.Type() {
+//					return seen
+//				}
+//				copied := reflect.New(value.Type().Elem())
+//				copies[value.Pointer()] = copied
+//				copied.Elem().Set(copyValue(value.Elem()))
+//				return copied
+//			...
+//			}
+//			return value
+//		}
+//		return copyValue(reflect.ValueOf(arg)).Interface()
+//	}
+//
+// Maps, slices, arrays, structs and the values pointers point to are copied,
+// and copies records the copies made of the latter so that pointers which
+// form a cycle are copied once. Values held by interfaces, such as a
+// context.Context, and by unexported fields are not copied, and neither are
+// those pointers point to that hold a lock, which go vet reports copies of.
+// reflect only appears in the body, as the packages in the signatures of
+// the fake's methods are taken to be those of the interface.
+func addDeepCopyMethod(funcDecls *[]*ast.FuncDecl, recv receiver, reflectName string, syncName string) {
+	reflectValue := selector(ast.NewIdent(reflectName), "Value")
+	reflectType := selector(ast.NewIdent(reflectName), "Type")
+
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent("deepCopy"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{ast.NewIdent("arg")},
+						Type:  ast.NewIdent("interface{}"),
+					},
+				},
+			},
+			Results: &ast.FieldList{
+				List: []*ast.Field{{Type: ast.NewIdent("interface{}")}},
+			},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				// copies := map[uintptr]reflect.Value{}
+				define(ast.NewIdent("copies"), &ast.CompositeLit{
+					Type: &ast.MapType{Key: ast.NewIdent("uintptr"), Value: reflectValue},
+				}),
+				// var holdsLock func(valueType reflect.Type) bool
+				// holdsLock = func(valueType reflect.Type) bool { ... }
+				declareFunc("holdsLock", "valueType", reflectType, ast.NewIdent("bool")),
+				&ast.AssignStmt{
+					Tok: token.ASSIGN,
+					Lhs: []ast.Expr{ast.NewIdent("holdsLock")},
+					Rhs: []ast.Expr{holdsLockFunc(reflectName, syncName)},
+				},
+				// var copyValue func(value reflect.Value) reflect.Value
+				// copyValue = func(value reflect.Value) reflect.Value { ... }
+				declareFunc("copyValue", "value", reflectValue, reflectValue),
+				&ast.AssignStmt{
+					Tok: token.ASSIGN,
+					Lhs: []ast.Expr{ast.NewIdent("copyValue")},
+					Rhs: []ast.Expr{copyValueFunc(reflectName)},
+				},
+				// return copyValue(reflect.ValueOf(arg)).Interface()
+				&ast.ReturnStmt{
+					Results: []ast.Expr{
+						methodCall(
+							callExpr(
+								ast.NewIdent("copyValue"),
+								callExpr(selector(ast.NewIdent(reflectName), "ValueOf"), ast.NewIdent("arg")),
+							),
+							"Interface",
+						),
+					},
+				},
+			},
+		},
+	})
+}
+
+// declareFunc returns the declaration of a func var, which a func literal
+// assigned to it afterwards can call recursively:
+//
+//	var name func(param paramType) resultType
+func declareFunc(name string, param string, paramType ast.Expr, resultType ast.Expr) ast.Stmt {
+	return &ast.DeclStmt{
+		Decl: &ast.GenDecl{
+			Tok: token.VAR,
+			Specs: []ast.Spec{
+				&ast.ValueSpec{
+					Names: []*ast.Ident{ast.NewIdent(name)},
+					Type:  funcType(param, paramType, resultType),
+				},
+			},
+		},
+	}
+}
+
+func funcType(param string, paramType ast.Expr, resultType ast.Expr) *ast.FuncType {
+	return &ast.FuncType{
+		Params: &ast.FieldList{
+			List: []*ast.Field{
+				{
+					Names: []*ast.Ident{ast.NewIdent(param)},
+					Type:  paramType,
+				},
+			},
+		},
+		Results: &ast.FieldList{
+			List: []*ast.Field{{Type: resultType}},
+		},
+	}
+}
+
+// copyValueFunc returns the func literal deepCopy copies values with.
+func copyValueFunc(reflectName string) *ast.FuncLit {
+	reflectValue := selector(ast.NewIdent(reflectName), "Value")
+	value := ast.NewIdent("value")
+	copied := ast.NewIdent("copied")
+	copies := ast.NewIdent("copies")
+
+	// copyValue(x)
+	var copyValue = func(x ast.Expr) ast.Expr {
+		return callExpr(ast.NewIdent("copyValue"), x)
+	}
+
+	// if value.IsNil() {
+	//   return value
+	// }
+	returnNil := &ast.IfStmt{
+		Cond: methodCall(value, "IsNil"),
+		Body: &ast.BlockStmt{List: []ast.Stmt{&ast.ReturnStmt{Results: []ast.Expr{value}}}},
+	}
+
+	// copied := reflect.New(value.Type()).Elem()
+	newValue := define(copied, methodCall(
+		callExpr(selector(ast.NewIdent(reflectName), "New"), methodCall(value, "Type")),
+		"Elem",
+	))
+
+	// for i := 0; i < value.n(); i++ {
+	//   body
+	// }
+	var forEach = func(n string, body ast.Stmt) ast.Stmt {
+		return &ast.ForStmt{
+			Init: define(ast.NewIdent("i"), &ast.BasicLit{Kind: token.INT, Value: "0"}),
+			Cond: &ast.BinaryExpr{X: ast.NewIdent("i"), Op: token.LSS, Y: methodCall(value, n)},
+			Post: &ast.IncDecStmt{X: ast.NewIdent("i"), Tok: token.INC},
+			Body: &ast.BlockStmt{List: []ast.Stmt{body}},
+		}
+	}
+
+	// copied.Index(i).Set(copyValue(value.Index(i)))
+	setIndex := &ast.ExprStmt{
+		X: methodCall(
+			methodCall(copied, "Index", ast.NewIdent("i")),
+			"Set",
+			copyValue(methodCall(value, "Index", ast.NewIdent("i"))),
+		),
+	}
+
+	var kindCase = func(kind string, body ...ast.Stmt) ast.Stmt {
+		return &ast.CaseClause{
+			List: []ast.Expr{selector(ast.NewIdent(reflectName), kind)},
+			Body: append(body, &ast.ReturnStmt{Results: []ast.Expr{copied}}),
+		}
+	}
+
+	pointerCase := kindCase("Ptr",
+		// if value.IsNil() || holdsLock(value.Type().Elem()) {
+		//   return value
+		// }
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{
+				X:  methodCall(value, "IsNil"),
+				Op: token.LOR,
+				Y:  callExpr(ast.NewIdent("holdsLock"), methodCall(methodCall(value, "Type"), "Elem")),
+			},
+			Body: &ast.BlockStmt{List: []ast.Stmt{&ast.ReturnStmt{Results: []ast.Expr{value}}}},
+		},
+		// if seen, found := copies[value.Pointer()]; found && seen.Type() == value.Type() {
+		//   return seen
+		// }
+		&ast.IfStmt{
+			Init: &ast.AssignStmt{
+				Tok: token.DEFINE,
+				Lhs: []ast.Expr{ast.NewIdent("seen"), ast.NewIdent("found")},
+				Rhs: []ast.Expr{&ast.IndexExpr{X: copies, Index: methodCall(value, "Pointer")}},
+			},
+			Cond: &ast.BinaryExpr{
+				X:  ast.NewIdent("found"),
+				Op: token.LAND,
+				Y: &ast.BinaryExpr{
+					X:  methodCall(ast.NewIdent("seen"), "Type"),
+					Op: token.EQL,
+					Y:  methodCall(value, "Type"),
+				},
+			},
+			Body: &ast.BlockStmt{List: []ast.Stmt{&ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("seen")}}}},
+		},
+		// copied := reflect.New(value.Type().Elem())
+		define(copied, callExpr(
+			selector(ast.NewIdent(reflectName), "New"),
+			methodCall(methodCall(value, "Type"), "Elem"),
+		)),
+		// copies[value.Pointer()] = copied
+		&ast.AssignStmt{
+			Tok: token.ASSIGN,
+			Lhs: []ast.Expr{&ast.IndexExpr{X: copies, Index: methodCall(value, "Pointer")}},
+			Rhs: []ast.Expr{copied},
+		},
+		// copied.Elem().Set(copyValue(value.Elem()))
+		&ast.ExprStmt{
+			X: methodCall(methodCall(copied, "Elem"), "Set", copyValue(methodCall(value, "Elem"))),
+		},
+	)
+
+	mapCase := kindCase("Map",
+		returnNil,
+		// copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		define(copied, callExpr(
+			selector(ast.NewIdent(reflectName), "MakeMapWithSize"),
+			methodCall(value, "Type"),
+			methodCall(value, "Len"),
+		)),
+		// iter := value.MapRange()
+		define(ast.NewIdent("iter"), methodCall(value, "MapRange")),
+		// for iter.Next() {
+		//   copied.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+		// }
+		&ast.ForStmt{
+			Cond: methodCall(ast.NewIdent("iter"), "Next"),
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{
+					&ast.ExprStmt{
+						X: methodCall(copied, "SetMapIndex",
+							methodCall(ast.NewIdent("iter"), "Key"),
+							copyValue(methodCall(ast.NewIdent("iter"), "Value")),
+						),
+					},
+				},
+			},
+		},
+	)
+
+	sliceCase := kindCase("Slice",
+		returnNil,
+		// copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		define(copied, callExpr(
+			selector(ast.NewIdent(reflectName), "MakeSlice"),
+			methodCall(value, "Type"),
+			methodCall(value, "Len"),
+			methodCall(value, "Len"),
+		)),
+		forEach("Len", setIndex),
+	)
+
+	arrayCase := kindCase("Array",
+		newValue,
+		forEach("Len", setIndex),
+	)
+
+	structCase := kindCase("Struct",
+		newValue,
+		// copied.Set(value)
+		&ast.ExprStmt{X: methodCall(copied, "Set", value)},
+		// unexported fields can't be set, so keep the values they were
+		// copied with
+		//
+		// if copied.Field(i).CanSet() {
+		//   copied.Field(i).Set(copyValue(value.Field(i)))
+		// }
+		forEach("NumField", &ast.IfStmt{
+			Cond: methodCall(methodCall(copied, "Field", ast.NewIdent("i")), "CanSet"),
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{
+					&ast.ExprStmt{
+						X: methodCall(
+							methodCall(copied, "Field", ast.NewIdent("i")),
+							"Set",
+							copyValue(methodCall(value, "Field", ast.NewIdent("i"))),
+						),
+					},
+				},
+			},
+		}),
+	)
+
+	return &ast.FuncLit{
+		Type: funcType("value", reflectValue, reflectValue),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.SwitchStmt{
+					Tag: methodCall(value, "Kind"),
+					Body: &ast.BlockStmt{
+						List: []ast.Stmt{pointerCase, mapCase, sliceCase, arrayCase, structCase},
+					},
+				},
+				&ast.ReturnStmt{Results: []ast.Expr{value}},
+			},
+		},
+	}
+}
+
+// holdsLockFunc returns the func literal deepCopy uses to leave values that
+// hold a lock uncopied, which are those go vet reports copies of:
+//
+//	func(valueType reflect.Type) bool {
+//		if reflect.PtrTo(valueType).Implements(reflect.TypeOf((*sync.Locker)(nil)).Elem()) {
+//			return true
+//		}
+//		switch valueType.Kind() {
+//		case reflect.Array:
+//			return holdsLock(valueType.Elem())
+//		case reflect.Struct:
+//			for i := 0; i < valueType.NumField(); i++ {
+//				if holdsLock(valueType.Field(i).Type) {
+//					return true
+//				}
+//			}
+//		}
+//		return false
+//	}
+func holdsLockFunc(reflectName string, syncName string) *ast.FuncLit {
+	valueType := ast.NewIdent("valueType")
+
+	// reflect.TypeOf((*sync.Locker)(nil)).Elem()
+	lockerType := methodCall(
+		callExpr(
+			selector(ast.NewIdent(reflectName), "TypeOf"),
+			callExpr(
+				&ast.ParenExpr{X: &ast.StarExpr{X: selector(ast.NewIdent(syncName), "Locker")}},
+				ast.NewIdent("nil"),
+			),
+		),
+		"Elem",
+	)
+
+	returnTrue := &ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("true")}}
+
+	return &ast.FuncLit{
+		Type: funcType("valueType", selector(ast.NewIdent(reflectName), "Type"), ast.NewIdent("bool")),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.IfStmt{
+					Cond: methodCall(
+						callExpr(selector(ast.NewIdent(reflectName), "PtrTo"), valueType),
+						"Implements",
+						lockerType,
+					),
+					Body: &ast.BlockStmt{List: []ast.Stmt{returnTrue}},
+				},
+				&ast.SwitchStmt{
+					Tag: methodCall(valueType, "Kind"),
+					Body: &ast.BlockStmt{
+						List: []ast.Stmt{
+							&ast.CaseClause{
+								List: []ast.Expr{selector(ast.NewIdent(reflectName), "Array")},
+								Body: []ast.Stmt{
+									&ast.ReturnStmt{
+										Results: []ast.Expr{
+											callExpr(ast.NewIdent("holdsLock"), methodCall(valueType, "Elem")),
+										},
+									},
+								},
+							},
+							&ast.CaseClause{
+								List: []ast.Expr{selector(ast.NewIdent(reflectName), "Struct")},
+								Body: []ast.Stmt{
+									&ast.ForStmt{
+										Init: define(ast.NewIdent("i"), &ast.BasicLit{Kind: token.INT, Value: "0"}),
+										Cond: &ast.BinaryExpr{
+											X:  ast.NewIdent("i"),
+											Op: token.LSS,
+											Y:  methodCall(valueType, "NumField"),
+										},
+										Post: &ast.IncDecStmt{X: ast.NewIdent("i"), Tok: token.INC},
+										Body: &ast.BlockStmt{
+											List: []ast.Stmt{
+												&ast.IfStmt{
+													Cond: callExpr(
+														ast.NewIdent("holdsLock"),
+														selector(methodCall(valueType, "Field", ast.NewIdent("i")), "Type"),
+													),
+													Body: &ast.BlockStmt{List: []ast.Stmt{returnTrue}},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("false")}},
+			},
+		},
+	}
+}
+
+// define returns the statement name := value.
+func define(name *ast.Ident, value ast.Expr) ast.Stmt {
+	return &ast.AssignStmt{
+		Tok: token.DEFINE,
+		Lhs: []ast.Expr{name},
+		Rhs: []ast.Expr{value},
+	}
+}
+
+// selector returns the selector x.name.
+func selector(x ast.Expr, name string) *ast.SelectorExpr {
+	return &ast.SelectorExpr{X: x, Sel: ast.NewIdent(name)}
+}
+
+// callExpr returns the call fun(args...).
+func callExpr(fun ast.Expr, args ...ast.Expr) *ast.CallExpr {
+	return &ast.CallExpr{Fun: fun, Args: args}
+}
+
+// methodCall returns the call x.name(args...).
+func methodCall(x ast.Expr, name string, args ...ast.Expr) *ast.CallExpr {
+	return callExpr(selector(x, name), args...)
+}
@@ -0,0 +1,92 @@
+package margarine
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+)
+
+// Error is returned when an interface cannot be faked. Err is one of the
+// error types below, or a plain error when there is nothing more specific to
+// report.
+type Error struct {
+	Pos       token.Position
+	Interface string
+	Err       error
+}
+
+func (e *Error) Error() string {
+	var parts []string
+	if e.Pos.IsValid() {
+		parts = append(parts, e.Pos.String())
+	}
+	if e.Interface != "" {
+		parts = append(parts, e.Interface)
+	}
+	parts = append(parts, e.Err.Error())
+
+	return strings.Join(parts, ": ")
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// UnsupportedError is returned for a construct that margarine does not know
+// how to fake, such as an embedded generic interface.
+type UnsupportedError struct {
+	Construct string
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("unsupported %s", e.Construct)
+}
+
+// NotAStructError is returned by Fakify when the declaration it is given is
+// not a struct.
+type NotAStructError struct {
+	Name string
+}
+
+func (e *NotAStructError) Error() string {
+	return fmt.Sprintf("%s is not a struct", e.Name)
+}
+
+// NotAnInterfaceError is returned when a type that is expected to be an
+// interface, either the one being faked or one it embeds, is not.
+type NotAnInterfaceError struct {
+	Name string
+}
+
+func (e *NotAnInterfaceError) Error() string {
+	return fmt.Sprintf("%s is not an interface", e.Name)
+}
+
+// NotFoundError is returned by FindInterface when no file in Dir declares the
+// interface. Candidates are the interfaces that are declared there.
+type NotFoundError struct {
+	Dir        string
+	Candidates []string
+}
+
+func (e *NotFoundError) Error() string {
+	if len(e.Candidates) == 0 {
+		return fmt.Sprintf("not found in %s, which declares no interfaces", e.Dir)
+	}
+	return fmt.Sprintf("not found in %s, which declares %s", e.Dir, strings.Join(e.Candidates, ", "))
+}
+
+// AmbiguousError is returned by FindInterface when more than one file
+// declares the interface, such as files for different platforms. Candidates
+// are the positions of the declarations.
+type AmbiguousError struct {
+	Candidates []token.Position
+}
+
+func (e *AmbiguousError) Error() string {
+	var positions []string
+	for _, pos := range e.Candidates {
+		positions = append(positions, pos.String())
+	}
+	return fmt.Sprintf("declared more than once, at %s", strings.Join(positions, ", "))
+}
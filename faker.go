@@ -4,44 +4,269 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
 	"unicode"
 	"unicode/utf8"
 )
 
+// FakifyOpts controls how FakifyWithOpts names the fake and which of its
+// optional methods are generated. The zero value produces the same fake as
+// Fakify.
 type FakifyOpts struct {
+	// StructName names the fake, overriding Prefix and Suffix.
 	StructName string
+
+	// Prefix and Suffix are added to the name of the struct being faked to
+	// name the fake. When neither is set, the prefix is "Fake".
+	Prefix string
+	Suffix string
+
+	// ReceiverName is the name of the receiver of the fake's methods, "fake"
+	// when not set. It must not be a name the methods already use, such as
+	// one of their locals or a package in their signatures.
+	ReceiverName string
+
+	// OmitCallCount, OmitArgsForCall, OmitResultsForCall, OmitReturns and
+	// OmitInvocations turn off generation of the XxxCallCount,
+	// XxxArgsForCall, XxxResultsForCall, XxxReturns and XxxReturnsOnCall, and
+	// Invocations methods respectively.
+	OmitCallCount      bool
+	OmitArgsForCall    bool
+	OmitResultsForCall bool
+	OmitReturns        bool
+	OmitInvocations    bool
+
+	// OmitStubSetters turns off generation of the XxxCalls methods, which
+	// set XxxStub under the method's mutex.
+	OmitStubSetters bool
+
+	// OmitReset turns off generation of the Reset and ResetStubs methods.
+	OmitReset bool
+
+	// OmitCalls turns off the log of calls kept across all of the fake's
+	// methods, and with it the Calls method and the XxxCall type of its
+	// entries.
+	//
+	// The Invocations, Calls, Reset and ResetStubs methods are also left out
+	// when the interface has a method of the same name, as hash.Hash has
+	// Reset, for the fake implements the interface's method instead.
+	OmitCalls bool
+
+	// DeepCopyArgs has the fake record deep copies of the args it is called
+	// with, so that what was recorded for a call is not changed by the
+	// caller changing the maps, slices and structs it passed afterwards.
+	// Without it only args declared as slices, such as []byte, and variadic
+	// args are copied, and only one level deep; named slice types such as
+	// net.IP are recorded as they are.
+	//
+	// The copies are made with reflection, and leave some values shared with
+	// the caller: those held by interfaces, such as a context.Context, and
+	// by unexported fields, and those pointers point to that hold a lock,
+	// such as a *sync.Mutex, which go vet reports copies of.
+	DeepCopyArgs bool
+
+	// Strict has a call to a method fail when the method has no stub and,
+	// for a method with results, no return values either. The call fails by
+	// calling the fake's FailUnstubbed field, which is usually set to a
+	// test's t.Fatalf, with the method name and args, or by panicking when
+	// it is not set. Without Strict such a call does nothing but record the
+	// call, and returns zero values.
+	Strict bool
 }
 
-func Fakify(genDecl *ast.GenDecl, funcDecls *[]*ast.FuncDecl) {
-	typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
-	if !ok {
-		panic("specs no good!")
+func Fakify(fset *token.FileSet, genDecl *ast.GenDecl, funcDecls *[]*ast.FuncDecl) error {
+	return FakifyWithOpts(fset, genDecl, funcDecls, FakifyOpts{})
+}
+
+// FakifyWithOpts is Fakify with the naming of the fake and the methods
+// generated for it controlled by opts.
+func FakifyWithOpts(fset *token.FileSet, genDecl *ast.GenDecl, funcDecls *[]*ast.FuncDecl, opts FakifyOpts) error {
+	if genDecl.Tok != token.TYPE || len(genDecl.Specs) != 1 {
+		var name string
+		if len(genDecl.Specs) > 0 {
+			if typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec); ok {
+				name = typeSpec.Name.Name
+			}
+		}
+
+		return &Error{
+			Pos:       fset.Position(genDecl.Pos()),
+			Interface: name,
+			Err:       &UnsupportedError{Construct: fmt.Sprintf("%s declaration with %d specs", genDecl.Tok, len(genDecl.Specs))},
+		}
 	}
 
-	typeSpec.Name.Name = "Fake" + typeSpec.Name.Name
+	typeSpec := genDecl.Specs[0].(*ast.TypeSpec)
 
 	structType, ok := typeSpec.Type.(*ast.StructType)
 	if !ok {
-		panic("typeSpec type no good!")
+		return &Error{
+			Pos:       fset.Position(typeSpec.Pos()),
+			Interface: typeSpec.Name.Name,
+			Err:       &NotAStructError{Name: typeSpec.Name.Name},
+		}
 	}
 
 	for _, funcDecl := range *funcDecls {
-		stubFuncOnStruct(structType, funcDecl)
+		if funcDecl.Type == nil || funcDecl.Name == nil {
+			return &Error{
+				Pos:       fset.Position(funcDecl.Pos()),
+				Interface: typeSpec.Name.Name,
+				Err:       &UnsupportedError{Construct: "method declaration without a name or type"},
+			}
+		}
+	}
+
+	recv := receiver{
+		name:     opts.ReceiverName,
+		typeSpec: typeSpec,
+	}
+	if recv.name == "" {
+		recv.name = "fake"
+	}
+
+	// the names the fake refers to the packages it uses itself by
+	pkgNames := ownPackageNames(typeSpec.TypeParams, *funcDecls)
 
-		privateName := privatize(funcDecl.Name.Name)
-		addMutexForFuncOnStruct(structType, privateName)
+	err := checkSignatureNames(typeSpec, *funcDecls)
+	if err != nil {
+		return &Error{
+			Pos:       fset.Position(typeSpec.Pos()),
+			Interface: typeSpec.Name.Name,
+			Err:       err,
+		}
+	}
+
+	err = checkReceiverName(recv.name, typeSpec, *funcDecls, pkgNames)
+	if err != nil {
+		return &Error{
+			Pos:       fset.Position(typeSpec.Pos()),
+			Interface: typeSpec.Name.Name,
+			Err:       err,
+		}
+	}
+
+	// the interface's own Invocations and Calls take the place of the
+	// fake's, and with it the log of calls
+	opts.OmitInvocations = opts.OmitInvocations || declaresMethod(*funcDecls, "Invocations")
+	opts.OmitCalls = opts.OmitCalls || declaresMethod(*funcDecls, "Calls")
 
-		if funcDecl.Type.Params.NumFields() > 0 {
-			addArgsForCallForFuncOnStruct(structType, funcDecl, privateName)
+	err = checkNames(*funcDecls, opts)
+	if err != nil {
+		return &Error{
+			Pos:       fset.Position(typeSpec.Pos()),
+			Interface: typeSpec.Name.Name,
+			Err:       err,
 		}
+	}
+
+	typeSpec.Name.Name = fakeName(typeSpec.Name.Name, opts)
+
+	// callType is the type of the entries in the log of calls, FakeXxxCall,
+	// and is empty when there is no log
+	var callType string
+	if !opts.OmitCalls {
+		callType = typeSpec.Name.Name + "Call"
+	}
+
+	deepCopies := copiesArgs(*funcDecls, opts)
+
+	methods := *funcDecls
+	*funcDecls = nil
+
+	var hasResults bool
+	for _, funcDecl := range methods {
+		normalizeSignature(funcDecl)
+		stubFuncOnStruct(structType, funcDecl)
+
+		privateName := fieldPrefix(funcDecl.Name.Name)
+		addMutexForFuncOnStruct(structType, privateName, pkgNames["sync"])
+
+		// methods without params still record an empty struct per call so
+		// that they can be counted
+		addArgsForCallForFuncOnStruct(structType, funcDecl, privateName)
 
 		if funcDecl.Type.Results.NumFields() > 0 {
+			hasResults = true
 			addReturnsStructField(structType, funcDecl, privateName)
+			if opts.Strict {
+				addReturnsSetStructField(structType, privateName)
+			}
+			addReturnsOnCallStructField(structType, funcDecl, privateName)
+			addResultsForCallStructField(structType, funcDecl, privateName)
+		}
+
+		implementFuncOnStruct(funcDecl, recv, privateName, opts, callType)
+		*funcDecls = append(*funcDecls, funcDecl)
+
+		if !opts.OmitCallCount {
+			addCallCountMethod(funcDecls, funcDecl, recv, privateName)
+		}
+
+		if !opts.OmitArgsForCall && funcDecl.Type.Params.NumFields() > 0 {
+			addArgsForCallMethod(funcDecls, funcDecl, recv, privateName)
+		}
+
+		if !opts.OmitResultsForCall && funcDecl.Type.Results.NumFields() > 0 {
+			addResultsForCallMethod(funcDecls, funcDecl, recv, privateName)
 		}
+
+		if !opts.OmitStubSetters {
+			addCallsSetterMethod(funcDecls, funcDecl, recv, privateName)
+		}
+
+		if !opts.OmitReturns && funcDecl.Type.Results.NumFields() > 0 {
+			addReturnsMethod(funcDecls, funcDecl, recv, privateName, opts.Strict)
+			addReturnsOnCallMethod(funcDecls, funcDecl, recv, privateName)
+		}
+	}
+
+	if !opts.OmitInvocations {
+		addInvocationsMethod(funcDecls, recv)
+	}
+
+	if callType != "" {
+		addCallType(genDecl, callType)
+		addCallsMethod(funcDecls, recv, callType)
 	}
 
-	addInvocationsMethod(funcDecls, typeSpec.Name.Name)
-	addRecordInvocationMethod(funcDecls, typeSpec.Name.Name)
+	if !opts.OmitReset && !declaresMethod(methods, "Reset") {
+		addResetMethod(funcDecls, methods, recv, callType)
+	}
+	if !opts.OmitReset && !declaresMethod(methods, "ResetStubs") {
+		addResetStubsMethod(funcDecls, methods, recv, opts.Strict)
+	}
+	addRecordInvocationMethod(funcDecls, recv, callType)
+	if callType != "" && hasResults {
+		addRecordResultsMethod(funcDecls, recv, callType)
+	}
+	if deepCopies {
+		addDeepCopyMethod(funcDecls, recv, pkgNames["reflect"], pkgNames["sync"])
+	}
+	if opts.Strict && len(methods) > 0 {
+		addFailUnstubbedMethod(funcDecls, recv, pkgNames["fmt"])
+
+		// FailUnstubbed func(format string, args ...interface{})
+		structType.Fields.List = append(structType.Fields.List, &ast.Field{
+			Names: []*ast.Ident{ast.NewIdent("FailUnstubbed")},
+			Type: &ast.FuncType{
+				Params: &ast.FieldList{
+					List: []*ast.Field{
+						{
+							Names: []*ast.Ident{ast.NewIdent("format")},
+							Type:  ast.NewIdent("string"),
+						},
+						{
+							Names: []*ast.Ident{ast.NewIdent("args")},
+							Type:  &ast.Ellipsis{Elt: ast.NewIdent("interface{}")},
+						},
+					},
+				},
+			},
+		})
+	}
 
 	structType.Fields.List = append(structType.Fields.List, &ast.Field{
 		Names: []*ast.Ident{ast.NewIdent("invocations")},
@@ -51,13 +276,346 @@ func Fakify(genDecl *ast.GenDecl, funcDecls *[]*ast.FuncDecl) {
 		},
 	})
 
+	if callType != "" {
+		// calls are guarded by invocationsMutex too, so that the two agree
+		structType.Fields.List = append(structType.Fields.List,
+			&ast.Field{
+				Names: []*ast.Ident{ast.NewIdent("calls")},
+				Type:  &ast.ArrayType{Elt: &ast.StarExpr{X: ast.NewIdent(callType)}},
+			},
+			&ast.Field{
+				Names: []*ast.Ident{ast.NewIdent("callSeq")},
+				Type:  ast.NewIdent("int"),
+			},
+		)
+	}
+
 	structType.Fields.List = append(structType.Fields.List, &ast.Field{
 		Names: []*ast.Ident{ast.NewIdent("invocationsMutex")},
 		Type: &ast.SelectorExpr{
-			X:   ast.NewIdent("sync"),
+			X:   ast.NewIdent(pkgNames["sync"]),
 			Sel: ast.NewIdent("RWMutex"),
 		},
 	})
+
+	return nil
+}
+
+// InterfaceAssertion returns the declaration
+//
+//	var _ pkgName.IfaceName = new(StructName)
+//
+// which fails to compile if the fake no longer implements the interface. When
+// pkgName is empty the interface is assumed to be in the fake's package.
+func InterfaceAssertion(structName string, pkgName string, ifaceName string) *ast.GenDecl {
+	var ifaceType ast.Expr = ast.NewIdent(ifaceName)
+	if pkgName != "" {
+		ifaceType = &ast.SelectorExpr{
+			X:   ast.NewIdent(pkgName),
+			Sel: ast.NewIdent(ifaceName),
+		}
+	}
+
+	return &ast.GenDecl{
+		Tok: token.VAR,
+		Specs: []ast.Spec{
+			&ast.ValueSpec{
+				Names: []*ast.Ident{ast.NewIdent("_")},
+				Type:  ifaceType,
+				Values: []ast.Expr{
+					&ast.CallExpr{
+						Fun:  ast.NewIdent("new"),
+						Args: []ast.Expr{ast.NewIdent(structName)},
+					},
+				},
+			},
+		},
+	}
+}
+
+// bodyNames are the names the generated method bodies use, which a receiver
+// of the same name would shadow or be shadowed by.
+var bodyNames = map[string]bool{
+	// locals
+	"arg": true, "args": true, "call": true, "callIndex": true,
+	"copied": true, "copiedCalls": true, "copiedInvocations": true,
+	"copies": true, "copyValue": true, "fakeReturns": true, "format": true,
+	"found": true, "holdsLock": true, "i": true, "iter": true, "key": true,
+	"message": true, "method": true, "results": true, "ret": true,
+	"returnsSet": true, "seen": true, "specificReturn": true, "stub": true,
+	"value": true, "valueType": true,
+
+	// packages
+	"fmt": true, "reflect": true, "sync": true,
+
+	// predeclared
+	"append": true, "bool": true, "copy": true, "false": true, "int": true,
+	"len": true, "make": true, "new": true, "nil": true, "panic": true,
+	"string": true, "true": true, "uintptr": true,
+}
+
+// argName matches the names normalizeSignature gives params, those of their
+// copies, and the names of the params of XxxReturns and XxxReturnsOnCall.
+var argName = regexp.MustCompile(`^(arg[0-9]+(Copy)?|result[0-9]+)$`)
+
+func checkReceiverName(name string, typeSpec *ast.TypeSpec, funcDecls []*ast.FuncDecl, pkgNames map[string]string) error {
+	if !token.IsIdentifier(name) || name == "_" {
+		return &UnsupportedError{Construct: fmt.Sprintf("receiver name %q, which is not an identifier", name)}
+	}
+
+	if bodyNames[name] || argName.MatchString(name) {
+		return &UnsupportedError{Construct: fmt.Sprintf("receiver name %q, which the fake's methods use", name)}
+	}
+
+	for _, pkgName := range pkgNames {
+		if name == pkgName {
+			return &UnsupportedError{Construct: fmt.Sprintf("receiver name %q, which the fake's methods use", name)}
+		}
+	}
+
+	if typeSpec.TypeParams != nil {
+		for _, field := range typeSpec.TypeParams.List {
+			for _, typeParam := range field.Names {
+				if typeParam.Name == name {
+					return &UnsupportedError{Construct: fmt.Sprintf("receiver name %q, which is the name of a type parameter", name)}
+				}
+			}
+		}
+	}
+
+	// a receiver named after a package would hide it from the signatures
+	for _, funcDecl := range funcDecls {
+		var used bool
+		ast.Inspect(funcDecl.Type, func(node ast.Node) bool {
+			if sel, ok := node.(*ast.SelectorExpr); ok {
+				if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == name {
+					used = true
+				}
+			}
+			return !used
+		})
+		if used {
+			return &UnsupportedError{Construct: fmt.Sprintf("receiver name %q, which is the name of a package the methods use", name)}
+		}
+	}
+
+	return nil
+}
+
+// checkSignatureNames returns an UnsupportedError when the signatures of
+// the interface refer to a package or type parameter by a name the fake's
+// methods use for a local, which would hide it from the types in their
+// bodies. The packages the fake uses itself are not checked, as those of
+// the same names are imported under other names.
+func checkSignatureNames(typeSpec *ast.TypeSpec, funcDecls []*ast.FuncDecl) error {
+	var ownPackage = func(name string) bool {
+		for _, importPath := range ownPackages {
+			if name == importPath {
+				return true
+			}
+		}
+		return false
+	}
+
+	var pkgNames []string
+	for name := range signaturePackages(typeSpec.TypeParams, funcDecls) {
+		pkgNames = append(pkgNames, name)
+	}
+	sort.Strings(pkgNames)
+
+	for _, name := range pkgNames {
+		if (bodyNames[name] && !ownPackage(name)) || argName.MatchString(name) {
+			return &UnsupportedError{Construct: fmt.Sprintf("package name %q, which the fake's methods use", name)}
+		}
+	}
+
+	if typeSpec.TypeParams != nil {
+		for _, field := range typeSpec.TypeParams.List {
+			for _, typeParam := range field.Names {
+				if bodyNames[typeParam.Name] || argName.MatchString(typeParam.Name) {
+					return &UnsupportedError{Construct: fmt.Sprintf("type parameter %q, which the fake's methods use", typeParam.Name)}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkNames returns an UnsupportedError when the fake would declare a
+// field or method twice, such as when the interface has methods Foo and
+// FooCalls, or a FailUnstubbed method while Strict is set.
+func checkNames(funcDecls []*ast.FuncDecl, opts FakifyOpts) error {
+	// the interface's methods are declared first, so that a clash names the
+	// method rather than what the fake adds for it
+	declared := map[string]string{}
+	for _, funcDecl := range funcDecls {
+		declared[funcDecl.Name.Name] = "method " + funcDecl.Name.Name
+	}
+
+	var clash error
+	var declare = func(kind string, name string, method string) {
+		what := fmt.Sprintf("the fake's %s %s", kind, name)
+		if method != "" {
+			what += " for " + method
+		}
+		if other, ok := declared[name]; ok && clash == nil {
+			clash = &UnsupportedError{Construct: fmt.Sprintf("%s, which clashes with %s", other, what)}
+		}
+		declared[name] = what
+	}
+
+	var hasResults bool
+	for _, funcDecl := range funcDecls {
+		name := funcDecl.Name.Name
+		privateName := fieldPrefix(name)
+		params := fieldTypes(funcDecl.Type.Params)
+		results := fieldTypes(funcDecl.Type.Results)
+
+		declare("field", name+"Stub", name)
+		declare("field", privateName+"Mutex", name)
+		declare("field", privateName+"ArgsForCall", name)
+		if len(results) > 0 {
+			hasResults = true
+			declare("field", privateName+"Returns", name)
+			if opts.Strict {
+				declare("field", privateName+"ReturnsSet", name)
+			}
+			declare("field", privateName+"ReturnsOnCall", name)
+			declare("field", privateName+"ResultsForCall", name)
+		}
+
+		if !opts.OmitCallCount {
+			declare("method", name+"CallCount", name)
+		}
+		if !opts.OmitArgsForCall && len(params) > 0 {
+			declare("method", name+"ArgsForCall", name)
+		}
+		if !opts.OmitResultsForCall && len(results) > 0 {
+			declare("method", name+"ResultsForCall", name)
+		}
+		if !opts.OmitStubSetters {
+			declare("method", name+"Calls", name)
+		}
+		if !opts.OmitReturns && len(results) > 0 {
+			declare("method", name+"Returns", name)
+			declare("method", name+"ReturnsOnCall", name)
+		}
+	}
+
+	if !opts.OmitInvocations {
+		declare("method", "Invocations", "")
+	}
+	if !opts.OmitCalls {
+		declare("method", "Calls", "")
+	}
+	if !opts.OmitReset && !declaresMethod(funcDecls, "Reset") {
+		declare("method", "Reset", "")
+	}
+	if !opts.OmitReset && !declaresMethod(funcDecls, "ResetStubs") {
+		declare("method", "ResetStubs", "")
+	}
+	declare("method", "recordInvocation", "")
+	if !opts.OmitCalls && hasResults {
+		declare("method", "recordResults", "")
+	}
+	if copiesArgs(funcDecls, opts) {
+		declare("method", "deepCopy", "")
+	}
+	if opts.Strict && len(funcDecls) > 0 {
+		declare("method", "failUnstubbed", "")
+		declare("field", "FailUnstubbed", "")
+	}
+
+	declare("field", "invocations", "")
+	if !opts.OmitCalls {
+		declare("field", "calls", "")
+		declare("field", "callSeq", "")
+	}
+	declare("field", "invocationsMutex", "")
+
+	return clash
+}
+
+// declaresMethod reports whether funcDecls, the interface's methods, include
+// one named name.
+func declaresMethod(funcDecls []*ast.FuncDecl, name string) bool {
+	for _, funcDecl := range funcDecls {
+		if funcDecl.Name.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func fakeName(name string, opts FakifyOpts) string {
+	if opts.StructName != "" {
+		return opts.StructName
+	}
+
+	if opts.Prefix == "" && opts.Suffix == "" {
+		return "Fake" + name
+	}
+
+	return opts.Prefix + name + opts.Suffix
+}
+
+// fieldTypes returns a type for each position in fl, so that grouped fields
+// such as (a, b int) and unnamed ones such as (int, int) count the same.
+func fieldTypes(fl *ast.FieldList) []ast.Expr {
+	if fl == nil {
+		return nil
+	}
+
+	var types []ast.Expr
+	for _, field := range fl.List {
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+
+		for i := 0; i < n; i++ {
+			types = append(types, field.Type)
+		}
+	}
+
+	return types
+}
+
+// normalizeSignature gives funcDecl a param per position named arg1, arg2,
+// etc. for the generated method body to refer to, and an unnamed result per
+// position.
+func normalizeSignature(funcDecl *ast.FuncDecl) {
+	params := &ast.FieldList{}
+	for i, fieldType := range fieldTypes(funcDecl.Type.Params) {
+		params.List = append(params.List, &ast.Field{
+			Names: []*ast.Ident{ast.NewIdent(fmt.Sprintf("arg%d", i+1))},
+			Type:  fieldType,
+		})
+	}
+
+	results := &ast.FieldList{}
+	for _, fieldType := range fieldTypes(funcDecl.Type.Results) {
+		results.List = append(results.List, &ast.Field{
+			Type: fieldType,
+		})
+	}
+
+	funcDecl.Type = &ast.FuncType{
+		Params:  params,
+		Results: results,
+	}
+}
+
+// fieldPrefix returns the prefix of the names of the fields the fake keeps
+// for the method name, such as fooMutex for Foo. Those of an Invocations
+// method are prefixed invocationsMethod, as invocationsMutex is the fake's
+// own.
+func fieldPrefix(name string) string {
+	if name == "Invocations" {
+		return "invocationsMethod"
+	}
+	return privatize(name)
 }
 
 func privatize(s string) string {
@@ -69,102 +627,908 @@ func privatize(s string) string {
 }
 
 func addReturnsStructField(structType *ast.StructType, funcDecl *ast.FuncDecl, privateName string) {
+	structType.Fields.List = append(structType.Fields.List, &ast.Field{
+		Names: []*ast.Ident{ast.NewIdent(privateName + "Returns")},
+		Type:  returnsStructType(funcDecl),
+	})
+}
+
+func addReturnsOnCallStructField(structType *ast.StructType, funcDecl *ast.FuncDecl, privateName string) {
+	structType.Fields.List = append(structType.Fields.List, &ast.Field{
+		Names: []*ast.Ident{ast.NewIdent(privateName + "ReturnsOnCall")},
+		Type: &ast.MapType{
+			Key:   ast.NewIdent("int"),
+			Value: returnsStructType(funcDecl),
+		},
+	})
+}
+
+func addReturnsSetStructField(structType *ast.StructType, privateName string) {
+	structType.Fields.List = append(structType.Fields.List, &ast.Field{
+		Names: []*ast.Ident{ast.NewIdent(privateName + "ReturnsSet")},
+		Type:  ast.NewIdent("bool"),
+	})
+}
+
+// addResultsForCallStructField adds the field that records what each call
+// returned, keyed by the index of the call:
+//
+//	methodResultsForCall map[int]struct {
+//		result1 int
+//	}
+func addResultsForCallStructField(structType *ast.StructType, funcDecl *ast.FuncDecl, privateName string) {
+	structType.Fields.List = append(structType.Fields.List, &ast.Field{
+		Names: []*ast.Ident{ast.NewIdent(privateName + "ResultsForCall")},
+		Type: &ast.MapType{
+			Key:   ast.NewIdent("int"),
+			Value: returnsStructType(funcDecl),
+		},
+	})
+}
+
+func returnsStructType(funcDecl *ast.FuncDecl) *ast.StructType {
 	var fields []*ast.Field
-	var i int
-	for _, field := range funcDecl.Type.Results.List {
-		i++
+	for i, fieldType := range fieldTypes(funcDecl.Type.Results) {
 		fields = append(fields, &ast.Field{
-			Type:  field.Type,
-			Names: []*ast.Ident{ast.NewIdent(fmt.Sprintf("result%d", i))},
+			Type:  fieldType,
+			Names: []*ast.Ident{ast.NewIdent(fmt.Sprintf("result%d", i+1))},
 		})
 	}
 
+	return &ast.StructType{
+		Fields: &ast.FieldList{
+			List: fields,
+		},
+	}
+}
+
+func addArgsForCallForFuncOnStruct(structType *ast.StructType, funcDecl *ast.FuncDecl, privateName string) {
 	structType.Fields.List = append(structType.Fields.List, &ast.Field{
-		Names: []*ast.Ident{ast.NewIdent(privateName + "Returns")},
-		Type: &ast.StructType{
-			Fields: &ast.FieldList{
-				List: fields,
-			},
+		Names: []*ast.Ident{ast.NewIdent(privateName + "ArgsForCall")},
+		Type: &ast.ArrayType{
+			Elt: argsForCallStructType(funcDecl),
 		},
 	})
 }
 
-func addArgsForCallForFuncOnStruct(structType *ast.StructType, funcDecl *ast.FuncDecl, privateName string) {
+func argsForCallStructType(funcDecl *ast.FuncDecl) *ast.StructType {
 	var fields []*ast.Field
-	var i int
-	for _, field := range funcDecl.Type.Params.List {
-		for range field.Names {
-			i++
-			var fieldType ast.Expr
-			if ellipsis, ok := field.Type.(*ast.Ellipsis); ok {
-				fieldType = &ast.ArrayType{Elt: ellipsis.Elt}
-			} else {
-				fieldType = field.Type
-			}
+	for i, fieldType := range fieldTypes(funcDecl.Type.Params) {
+		if ellipsis, ok := fieldType.(*ast.Ellipsis); ok {
+			fieldType = &ast.ArrayType{Elt: ellipsis.Elt}
+		}
 
-			fields = append(fields, &ast.Field{
-				Names: []*ast.Ident{
-					ast.NewIdent(fmt.Sprintf("arg%d", i)),
-				},
+		fields = append(fields, &ast.Field{
+			Names: []*ast.Ident{
+				ast.NewIdent(fmt.Sprintf("arg%d", i+1)),
+			},
+			Type: fieldType,
+		})
+	}
+
+	return &ast.StructType{
+		Fields: &ast.FieldList{
+			List: fields,
+		},
+	}
+}
+
+func addMutexForFuncOnStruct(structType *ast.StructType, privateName string, syncName string) {
+	structType.Fields.List = append(structType.Fields.List, &ast.Field{
+		Type: &ast.SelectorExpr{
+			X:   ast.NewIdent(syncName),
+			Sel: ast.NewIdent("RWMutex"),
+		},
+		Names: []*ast.Ident{ast.NewIdent(privateName + "Mutex")},
+	})
+}
+
+func stubFuncOnStruct(structType *ast.StructType, funcDecl *ast.FuncDecl) {
+	structType.Fields.List = append(structType.Fields.List, &ast.Field{
+		Names: []*ast.Ident{
+			ast.NewIdent(funcDecl.Name.Name + "Stub"), // missing Obj; necessary? would include Kind: var
+		},
+		Type: stubFuncType(funcDecl),
+	})
+}
+
+func stubFuncType(funcDecl *ast.FuncDecl) *ast.FuncType {
+	var singularizeFields = func(fl *ast.FieldList) *ast.FieldList {
+		result := &ast.FieldList{}
+
+		for _, fieldType := range fieldTypes(fl) {
+			result.List = append(result.List, &ast.Field{
 				Type: fieldType,
 			})
 		}
+
+		return result
 	}
 
-	structType.Fields.List = append(structType.Fields.List, &ast.Field{
-		Names: []*ast.Ident{ast.NewIdent(privateName + "ArgsForCall")},
-		Type: &ast.ArrayType{
-			Elt: &ast.StructType{
-				Fields: &ast.FieldList{
-					List: fields,
+	return &ast.FuncType{
+		Params:  singularizeFields(funcDecl.Type.Params),
+		Results: singularizeFields(funcDecl.Type.Results),
+	}
+}
+
+func implementFuncOnStruct(funcDecl *ast.FuncDecl, recv receiver, privateName string, opts FakifyOpts, callType string) {
+	mutexName := privateName + "Mutex"
+	stubName := funcDecl.Name.Name + "Stub"
+	hasResults := funcDecl.Type.Results.NumFields() > 0
+
+	var statements []ast.Stmt
+
+	// args are passed on to the stub, while recordedArgs are what is
+	// recorded for the call, which for slices and variadic args, and for all
+	// args that hold memory with DeepCopyArgs, is a copy so that later
+	// changes by the caller don't rewrite what was recorded
+	var args, recordedArgs []ast.Expr
+	var ellipsis token.Pos
+	for _, field := range funcDecl.Type.Params.List {
+		argName := field.Names[0].Name
+		args = append(args, ast.NewIdent(argName))
+
+		if _, ok := field.Type.(*ast.Ellipsis); ok {
+			// any valid position has the printer emit the ellipsis
+			ellipsis = 1
+		}
+
+		copyName := argName + "Copy"
+		if opts.DeepCopyArgs && needsDeepCopy(field.Type) {
+			statements = append(statements, deepCopyArg(recv, copyName, argName, field.Type))
+			recordedArgs = append(recordedArgs, ast.NewIdent(copyName))
+			continue
+		}
+		if copyStmts := copyArg(copyName, argName, field.Type); copyStmts != nil {
+			statements = append(statements, copyStmts...)
+			recordedArgs = append(recordedArgs, ast.NewIdent(copyName))
+			continue
+		}
+
+		recordedArgs = append(recordedArgs, ast.NewIdent(argName))
+	}
+
+	statements = append(statements,
+		// fake.methodMutex.Lock()
+		&ast.ExprStmt{X: recv.mutexCall(mutexName, "Lock")},
+		// the stub is only read under the lock, as XxxCalls sets it under
+		// the lock too
+		//
+		// stub := fake.MethodStub
+		&ast.AssignStmt{
+			Tok: token.DEFINE,
+			Lhs: []ast.Expr{ast.NewIdent("stub")},
+			Rhs: []ast.Expr{recv.field(stubName)},
+		},
+	)
+
+	if hasResults {
+		statements = append(statements,
+			// callIndex := len(fake.methodArgsForCall)
+			&ast.AssignStmt{
+				Tok: token.DEFINE,
+				Lhs: []ast.Expr{ast.NewIdent("callIndex")},
+				Rhs: []ast.Expr{
+					&ast.CallExpr{
+						Fun:  ast.NewIdent("len"),
+						Args: []ast.Expr{recv.field(privateName + "ArgsForCall")},
+					},
+				},
+			},
+			// ret, specificReturn := fake.methodReturnsOnCall[callIndex]
+			&ast.AssignStmt{
+				Tok: token.DEFINE,
+				Lhs: []ast.Expr{ast.NewIdent("ret"), ast.NewIdent("specificReturn")},
+				Rhs: []ast.Expr{
+					&ast.IndexExpr{
+						X:     recv.field(privateName + "ReturnsOnCall"),
+						Index: ast.NewIdent("callIndex"),
+					},
+				},
+			},
+			// fakeReturns := fake.methodReturns
+			&ast.AssignStmt{
+				Tok: token.DEFINE,
+				Lhs: []ast.Expr{ast.NewIdent("fakeReturns")},
+				Rhs: []ast.Expr{recv.field(privateName + "Returns")},
+			},
+		)
+
+		if opts.Strict {
+			// returnsSet := fake.methodReturnsSet
+			statements = append(statements, &ast.AssignStmt{
+				Tok: token.DEFINE,
+				Lhs: []ast.Expr{ast.NewIdent("returnsSet")},
+				Rhs: []ast.Expr{recv.field(privateName + "ReturnsSet")},
+			})
+		}
+	}
+
+	statements = append(statements,
+		// fake.methodArgsForCall = append(fake.methodArgsForCall, struct{...}{...})
+		&ast.AssignStmt{
+			Tok: token.ASSIGN,
+			Lhs: []ast.Expr{recv.field(privateName + "ArgsForCall")},
+			Rhs: []ast.Expr{
+				&ast.CallExpr{
+					Fun: ast.NewIdent("append"),
+					Args: []ast.Expr{
+						recv.field(privateName + "ArgsForCall"),
+						&ast.CompositeLit{
+							Type: argsForCallStructType(funcDecl),
+							Elts: recordedArgs,
+						},
+					},
+				},
+			},
+		},
+		// fake.methodMutex.Unlock()
+		&ast.ExprStmt{X: recv.mutexCall(mutexName, "Unlock")},
+	)
+
+	// fake.recordInvocation("Method", []interface{}{...})
+	recordCall := &ast.CallExpr{
+		Fun: recv.field("recordInvocation"),
+		Args: []ast.Expr{
+			&ast.BasicLit{
+				Kind:  token.STRING,
+				Value: strconv.Quote(funcDecl.Name.Name),
+			},
+			&ast.CompositeLit{
+				Type: ast.NewIdent("[]interface{}"),
+				Elts: recordedArgs,
+			},
+		},
+	}
+
+	// stub(...)
+	stubCall := &ast.CallExpr{
+		Fun:      ast.NewIdent("stub"),
+		Args:     args,
+		Ellipsis: ellipsis,
+	}
+
+	// if stub != nil {
+	stubCond := &ast.BinaryExpr{
+		X:  ast.NewIdent("stub"),
+		Op: token.NEQ,
+		Y:  ast.NewIdent("nil"),
+	}
+
+	// fake.failUnstubbed("Method", []interface{}{...})
+	failUnstubbed := &ast.ExprStmt{
+		X: &ast.CallExpr{
+			Fun: recv.field("failUnstubbed"),
+			Args: []ast.Expr{
+				&ast.BasicLit{
+					Kind:  token.STRING,
+					Value: strconv.Quote(funcDecl.Name.Name),
+				},
+				&ast.CompositeLit{
+					Type: ast.NewIdent("[]interface{}"),
+					Elts: recordedArgs,
+				},
+			},
+		},
+	}
+
+	if !hasResults {
+		callStub := &ast.IfStmt{
+			Cond: stubCond,
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{&ast.ExprStmt{X: stubCall}},
+			},
+		}
+
+		if opts.Strict {
+			// } else {
+			//   fake.failUnstubbed("Method", []interface{}{...})
+			// }
+			callStub.Else = &ast.BlockStmt{
+				List: []ast.Stmt{failUnstubbed},
+			}
+		}
+
+		statements = append(statements, &ast.ExprStmt{X: recordCall}, callStub)
+
+		funcDecl.Recv = recv.fieldList()
+		funcDecl.Body = &ast.BlockStmt{
+			List: statements,
+		}
+		return
+	}
+
+	if callType != "" {
+		// call := fake.recordInvocation(...)
+		statements = append(statements, &ast.AssignStmt{
+			Tok: token.DEFINE,
+			Lhs: []ast.Expr{ast.NewIdent("call")},
+			Rhs: []ast.Expr{recordCall},
+		})
+	} else {
+		statements = append(statements, &ast.ExprStmt{X: recordCall})
+	}
+
+	if opts.Strict {
+		// if stub == nil && !specificReturn && !returnsSet {
+		//   fake.failUnstubbed("Method", []interface{}{...})
+		// }
+		statements = append(statements, &ast.IfStmt{
+			Cond: &ast.BinaryExpr{
+				X: &ast.BinaryExpr{
+					X: &ast.BinaryExpr{
+						X:  ast.NewIdent("stub"),
+						Op: token.EQL,
+						Y:  ast.NewIdent("nil"),
+					},
+					Op: token.LAND,
+					Y:  &ast.UnaryExpr{Op: token.NOT, X: ast.NewIdent("specificReturn")},
+				},
+				Op: token.LAND,
+				Y:  &ast.UnaryExpr{Op: token.NOT, X: ast.NewIdent("returnsSet")},
+			},
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{failUnstubbed},
+			},
+		})
+	}
+
+	var results, resultFields []ast.Expr
+	for _, field := range returnsStructType(funcDecl).Fields.List {
+		resultFields = append(resultFields, &ast.SelectorExpr{
+			X:   ast.NewIdent("results"),
+			Sel: ast.NewIdent(field.Names[0].Name),
+		})
+		results = append(results, &ast.SelectorExpr{
+			X:   ast.NewIdent("results"),
+			Sel: ast.NewIdent(field.Names[0].Name),
+		})
+	}
+
+	statements = append(statements,
+		// results := fakeReturns
+		&ast.AssignStmt{
+			Tok: token.DEFINE,
+			Lhs: []ast.Expr{ast.NewIdent("results")},
+			Rhs: []ast.Expr{ast.NewIdent("fakeReturns")},
+		},
+		// if specificReturn {
+		//   results = ret
+		// }
+		&ast.IfStmt{
+			Cond: ast.NewIdent("specificReturn"),
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{
+					&ast.AssignStmt{
+						Tok: token.ASSIGN,
+						Lhs: []ast.Expr{ast.NewIdent("results")},
+						Rhs: []ast.Expr{ast.NewIdent("ret")},
+					},
+				},
+			},
+		},
+		// if stub != nil {
+		//   results.result1, ... = stub(...)
+		// }
+		&ast.IfStmt{
+			Cond: stubCond,
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{
+					&ast.AssignStmt{
+						Tok: token.ASSIGN,
+						Lhs: resultFields,
+						Rhs: []ast.Expr{stubCall},
+					},
+				},
+			},
+		},
+		// fake.methodMutex.Lock()
+		&ast.ExprStmt{X: recv.mutexCall(mutexName, "Lock")},
+		// if fake.methodResultsForCall == nil {
+		//   fake.methodResultsForCall = make(map[int]struct{...})
+		// }
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{
+				X:  recv.field(privateName + "ResultsForCall"),
+				Op: token.EQL,
+				Y:  ast.NewIdent("nil"),
+			},
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{
+					&ast.AssignStmt{
+						Tok: token.ASSIGN,
+						Lhs: []ast.Expr{recv.field(privateName + "ResultsForCall")},
+						Rhs: []ast.Expr{
+							&ast.CallExpr{
+								Fun: ast.NewIdent("make"),
+								Args: []ast.Expr{
+									&ast.MapType{
+										Key:   ast.NewIdent("int"),
+										Value: returnsStructType(funcDecl),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		// fake.methodResultsForCall[callIndex] = results
+		&ast.AssignStmt{
+			Tok: token.ASSIGN,
+			Lhs: []ast.Expr{
+				&ast.IndexExpr{
+					X:     recv.field(privateName + "ResultsForCall"),
+					Index: ast.NewIdent("callIndex"),
+				},
+			},
+			Rhs: []ast.Expr{ast.NewIdent("results")},
+		},
+		// fake.methodMutex.Unlock()
+		&ast.ExprStmt{X: recv.mutexCall(mutexName, "Unlock")},
+	)
+
+	if callType != "" {
+		// fake.recordResults(call, []interface{}{results.result1, ...})
+		statements = append(statements, &ast.ExprStmt{
+			X: &ast.CallExpr{
+				Fun: recv.field("recordResults"),
+				Args: []ast.Expr{
+					ast.NewIdent("call"),
+					&ast.CompositeLit{
+						Type: ast.NewIdent("[]interface{}"),
+						Elts: results,
+					},
+				},
+			},
+		})
+	}
+
+	// return results.result1, ...
+	statements = append(statements, &ast.ReturnStmt{Results: results})
+
+	funcDecl.Recv = recv.fieldList()
+	funcDecl.Body = &ast.BlockStmt{
+		List: statements,
+	}
+}
+
+// copyArg returns the statements that declare copyName as a copy of the arg
+// argName, or nil when args of argType are recorded as they are. Only slices
+// are copied, one level deep.
+//
+//	var argCopy []T
+//	if arg != nil {
+//		argCopy = make([]T, len(arg))
+//		copy(argCopy, arg)
+//	}
+func copyArg(copyName string, argName string, argType ast.Expr) []ast.Stmt {
+	var copyType ast.Expr
+
+	switch t := argType.(type) {
+	case *ast.Ellipsis:
+		copyType = &ast.ArrayType{Elt: t.Elt}
+	case *ast.ArrayType:
+		if t.Len != nil {
+			// arrays are already copied when passed
+			return nil
+		}
+		copyType = t
+	default:
+		return nil
+	}
+
+	return []ast.Stmt{
+		&ast.DeclStmt{
+			Decl: &ast.GenDecl{
+				Tok: token.VAR,
+				Specs: []ast.Spec{
+					&ast.ValueSpec{
+						Names: []*ast.Ident{ast.NewIdent(copyName)},
+						Type:  copyType,
+					},
+				},
+			},
+		},
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{
+				X:  ast.NewIdent(argName),
+				Op: token.NEQ,
+				Y:  ast.NewIdent("nil"),
+			},
+			Body: &ast.BlockStmt{List: copySlice(copyName, argName, copyType)},
+		},
+	}
+}
+
+func copySlice(copyName string, argName string, sliceType ast.Expr) []ast.Stmt {
+	return []ast.Stmt{
+		// argCopy = make([]T, len(arg))
+		&ast.AssignStmt{
+			Tok: token.ASSIGN,
+			Lhs: []ast.Expr{ast.NewIdent(copyName)},
+			Rhs: []ast.Expr{
+				&ast.CallExpr{
+					Fun: ast.NewIdent("make"),
+					Args: []ast.Expr{
+						sliceType,
+						&ast.CallExpr{
+							Fun:  ast.NewIdent("len"),
+							Args: []ast.Expr{ast.NewIdent(argName)},
+						},
+					},
+				},
+			},
+		},
+		// copy(argCopy, arg)
+		&ast.ExprStmt{
+			X: &ast.CallExpr{
+				Fun:  ast.NewIdent("copy"),
+				Args: []ast.Expr{ast.NewIdent(copyName), ast.NewIdent(argName)},
+			},
+		},
+	}
+}
+
+func addCallCountMethod(funcDecls *[]*ast.FuncDecl, funcDecl *ast.FuncDecl, recv receiver, privateName string) {
+	mutexName := privateName + "Mutex"
+
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent(funcDecl.Name.Name + "CallCount"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{},
+			Results: &ast.FieldList{
+				List: []*ast.Field{{
+					Type: ast.NewIdent("int"),
+				}},
+			},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				// fake.methodMutex.RLock()
+				&ast.ExprStmt{X: recv.mutexCall(mutexName, "RLock")},
+				// defer fake.methodMutex.RUnlock()
+				&ast.DeferStmt{Call: recv.mutexCall(mutexName, "RUnlock")},
+				// return len(fake.methodArgsForCall)
+				&ast.ReturnStmt{
+					Results: []ast.Expr{
+						&ast.CallExpr{
+							Fun:  ast.NewIdent("len"),
+							Args: []ast.Expr{recv.field(privateName + "ArgsForCall")},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func addArgsForCallMethod(funcDecls *[]*ast.FuncDecl, funcDecl *ast.FuncDecl, recv receiver, privateName string) {
+	mutexName := privateName + "Mutex"
+
+	var resultFields []*ast.Field
+	var results []ast.Expr
+	for _, field := range argsForCallStructType(funcDecl).Fields.List {
+		resultFields = append(resultFields, &ast.Field{
+			Type: field.Type,
+		})
+
+		// fake.methodArgsForCall[i].arg1
+		results = append(results, &ast.SelectorExpr{
+			X: &ast.IndexExpr{
+				X:     recv.field(privateName + "ArgsForCall"),
+				Index: ast.NewIdent("i"),
+			},
+			Sel: ast.NewIdent(field.Names[0].Name),
+		})
+	}
+
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent(funcDecl.Name.Name + "ArgsForCall"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{{
+					Names: []*ast.Ident{ast.NewIdent("i")},
+					Type:  ast.NewIdent("int"),
+				}},
+			},
+			Results: &ast.FieldList{
+				List: resultFields,
+			},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				// fake.methodMutex.RLock()
+				&ast.ExprStmt{X: recv.mutexCall(mutexName, "RLock")},
+				// defer fake.methodMutex.RUnlock()
+				&ast.DeferStmt{Call: recv.mutexCall(mutexName, "RUnlock")},
+				// return fake.methodArgsForCall[i].arg1, ...
+				&ast.ReturnStmt{Results: results},
+			},
+		},
+	})
+}
+
+func addResultsForCallMethod(funcDecls *[]*ast.FuncDecl, funcDecl *ast.FuncDecl, recv receiver, privateName string) {
+	mutexName := privateName + "Mutex"
+
+	var resultFields []*ast.Field
+	var results []ast.Expr
+	for _, field := range returnsStructType(funcDecl).Fields.List {
+		resultFields = append(resultFields, &ast.Field{
+			Type: field.Type,
+		})
+
+		// fake.methodResultsForCall[i].result1
+		results = append(results, &ast.SelectorExpr{
+			X: &ast.IndexExpr{
+				X:     recv.field(privateName + "ResultsForCall"),
+				Index: ast.NewIdent("i"),
+			},
+			Sel: ast.NewIdent(field.Names[0].Name),
+		})
+	}
+
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent(funcDecl.Name.Name + "ResultsForCall"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{{
+					Names: []*ast.Ident{ast.NewIdent("i")},
+					Type:  ast.NewIdent("int"),
+				}},
+			},
+			Results: &ast.FieldList{
+				List: resultFields,
+			},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				// fake.methodMutex.RLock()
+				&ast.ExprStmt{X: recv.mutexCall(mutexName, "RLock")},
+				// defer fake.methodMutex.RUnlock()
+				&ast.DeferStmt{Call: recv.mutexCall(mutexName, "RUnlock")},
+				// return fake.methodResultsForCall[i].result1, ...
+				&ast.ReturnStmt{Results: results},
+			},
+		},
+	})
+}
+
+func addCallsSetterMethod(funcDecls *[]*ast.FuncDecl, funcDecl *ast.FuncDecl, recv receiver, privateName string) {
+	mutexName := privateName + "Mutex"
+
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent(funcDecl.Name.Name + "Calls"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{{
+					Names: []*ast.Ident{ast.NewIdent("stub")},
+					Type:  stubFuncType(funcDecl),
+				}},
+			},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				// fake.methodMutex.Lock()
+				&ast.ExprStmt{X: recv.mutexCall(mutexName, "Lock")},
+				// defer fake.methodMutex.Unlock()
+				&ast.DeferStmt{Call: recv.mutexCall(mutexName, "Unlock")},
+				// fake.MethodStub = stub
+				&ast.AssignStmt{
+					Tok: token.ASSIGN,
+					Lhs: []ast.Expr{recv.field(funcDecl.Name.Name + "Stub")},
+					Rhs: []ast.Expr{ast.NewIdent("stub")},
+				},
+			},
+		},
+	})
+}
+
+func addReturnsMethod(funcDecls *[]*ast.FuncDecl, funcDecl *ast.FuncDecl, recv receiver, privateName string, strict bool) {
+	mutexName := privateName + "Mutex"
+	returnsType := returnsStructType(funcDecl)
+
+	var params []*ast.Field
+	var results []ast.Expr
+	for _, field := range returnsType.Fields.List {
+		params = append(params, field)
+		results = append(results, ast.NewIdent(field.Names[0].Name))
+	}
+
+	returnsMethod := &ast.FuncDecl{
+		Name: ast.NewIdent(funcDecl.Name.Name + "Returns"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: params,
+			},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				// fake.methodMutex.Lock()
+				&ast.ExprStmt{X: recv.mutexCall(mutexName, "Lock")},
+				// defer fake.methodMutex.Unlock()
+				&ast.DeferStmt{Call: recv.mutexCall(mutexName, "Unlock")},
+				// fake.MethodStub = nil
+				&ast.AssignStmt{
+					Tok: token.ASSIGN,
+					Lhs: []ast.Expr{recv.field(funcDecl.Name.Name + "Stub")},
+					Rhs: []ast.Expr{ast.NewIdent("nil")},
+				},
+				// fake.methodReturns = struct{...}{result1, ...}
+				&ast.AssignStmt{
+					Tok: token.ASSIGN,
+					Lhs: []ast.Expr{recv.field(privateName + "Returns")},
+					Rhs: []ast.Expr{
+						&ast.CompositeLit{
+							Type: returnsType,
+							Elts: results,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if strict {
+		// fake.methodReturnsSet = true
+		returnsMethod.Body.List = append(returnsMethod.Body.List, &ast.AssignStmt{
+			Tok: token.ASSIGN,
+			Lhs: []ast.Expr{recv.field(privateName + "ReturnsSet")},
+			Rhs: []ast.Expr{ast.NewIdent("true")},
+		})
+	}
+
+	*funcDecls = append(*funcDecls, returnsMethod)
+}
+
+func addReturnsOnCallMethod(funcDecls *[]*ast.FuncDecl, funcDecl *ast.FuncDecl, recv receiver, privateName string) {
+	mutexName := privateName + "Mutex"
+	returnsType := returnsStructType(funcDecl)
+
+	params := []*ast.Field{{
+		Names: []*ast.Ident{ast.NewIdent("i")},
+		Type:  ast.NewIdent("int"),
+	}}
+	var results []ast.Expr
+	for _, field := range returnsType.Fields.List {
+		params = append(params, field)
+		results = append(results, ast.NewIdent(field.Names[0].Name))
+	}
+
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent(funcDecl.Name.Name + "ReturnsOnCall"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: params,
+			},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				// fake.methodMutex.Lock()
+				&ast.ExprStmt{X: recv.mutexCall(mutexName, "Lock")},
+				// defer fake.methodMutex.Unlock()
+				&ast.DeferStmt{Call: recv.mutexCall(mutexName, "Unlock")},
+				// fake.MethodStub = nil
+				&ast.AssignStmt{
+					Tok: token.ASSIGN,
+					Lhs: []ast.Expr{recv.field(funcDecl.Name.Name + "Stub")},
+					Rhs: []ast.Expr{ast.NewIdent("nil")},
+				},
+				// if fake.methodReturnsOnCall == nil {
+				//   fake.methodReturnsOnCall = make(map[int]struct{...})
+				// }
+				&ast.IfStmt{
+					Cond: &ast.BinaryExpr{
+						X:  recv.field(privateName + "ReturnsOnCall"),
+						Op: token.EQL,
+						Y:  ast.NewIdent("nil"),
+					},
+					Body: &ast.BlockStmt{
+						List: []ast.Stmt{
+							&ast.AssignStmt{
+								Tok: token.ASSIGN,
+								Lhs: []ast.Expr{recv.field(privateName + "ReturnsOnCall")},
+								Rhs: []ast.Expr{
+									&ast.CallExpr{
+										Fun: ast.NewIdent("make"),
+										Args: []ast.Expr{
+											&ast.MapType{
+												Key:   ast.NewIdent("int"),
+												Value: returnsType,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				// fake.methodReturnsOnCall[i] = struct{...}{result1, ...}
+				&ast.AssignStmt{
+					Tok: token.ASSIGN,
+					Lhs: []ast.Expr{
+						&ast.IndexExpr{
+							X:     recv.field(privateName + "ReturnsOnCall"),
+							Index: ast.NewIdent("i"),
+						},
+					},
+					Rhs: []ast.Expr{
+						&ast.CompositeLit{
+							Type: returnsType,
+							Elts: results,
+						},
+					},
 				},
 			},
 		},
 	})
 }
 
-func addMutexForFuncOnStruct(structType *ast.StructType, privateName string) {
-	structType.Fields.List = append(structType.Fields.List, &ast.Field{
-		Type: &ast.SelectorExpr{
-			X:   ast.NewIdent("sync"),
-			Sel: ast.NewIdent("RWMutex"),
-		},
-		Names: []*ast.Ident{ast.NewIdent(privateName + "Mutex")},
-	})
+// receiver is the receiver of the fake's generated methods, named after
+// FakifyOpts.ReceiverName.
+type receiver struct {
+	name     string
+	typeSpec *ast.TypeSpec
 }
 
-func stubFuncOnStruct(structType *ast.StructType, funcDecl *ast.FuncDecl) {
-	var singularizeFields = func(fl *ast.FieldList) *ast.FieldList {
-		result := &ast.FieldList{}
+func (recv receiver) fieldList() *ast.FieldList {
+	var recvType ast.Expr = ast.NewIdent(recv.typeSpec.Name.Name)
 
-		if fl.NumFields() > 0 {
-			for _, field := range fl.List {
-				var i int
-				for range field.Names {
-					i++
-					result.List = append(result.List, &ast.Field{
-						Type: field.Type,
-					})
-				}
+	// *FakeStore[K, V]
+	var typeArgs []ast.Expr
+	if recv.typeSpec.TypeParams != nil {
+		for _, field := range recv.typeSpec.TypeParams.List {
+			for _, name := range field.Names {
+				typeArgs = append(typeArgs, ast.NewIdent(name.Name))
 			}
 		}
+	}
 
-		return result
+	switch len(typeArgs) {
+	case 0:
+	case 1:
+		recvType = &ast.IndexExpr{X: recvType, Index: typeArgs[0]}
+	default:
+		recvType = &ast.IndexListExpr{X: recvType, Indices: typeArgs}
 	}
 
-	structType.Fields.List = append(structType.Fields.List, &ast.Field{
-		Names: []*ast.Ident{
-			ast.NewIdent(funcDecl.Name.Name + "Stub"), // missing Obj; necessary? would include Kind: var
+	return &ast.FieldList{
+		List: []*ast.Field{
+			{
+				Names: []*ast.Ident{ast.NewIdent(recv.name)},
+				Type:  &ast.StarExpr{X: recvType},
+			},
 		},
-		Type: &ast.FuncType{
-			Params:  singularizeFields(funcDecl.Type.Params),
-			Results: singularizeFields(funcDecl.Type.Results),
+	}
+}
+
+// field returns the selector fake.name.
+func (recv receiver) field(name string) *ast.SelectorExpr {
+	return &ast.SelectorExpr{
+		X:   ast.NewIdent(recv.name),
+		Sel: ast.NewIdent(name),
+	}
+}
+
+// mutexCall returns the call fake.mutexName.method().
+func (recv receiver) mutexCall(mutexName string, method string) *ast.CallExpr {
+	return &ast.CallExpr{
+		Fun: &ast.SelectorExpr{
+			X:   recv.field(mutexName),
+			Sel: ast.NewIdent(method),
 		},
-	})
+	}
 }
 
-func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, structName string) {
-	newFuncDecls := append(*funcDecls, &ast.FuncDecl{
+func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, recv receiver, callType string) {
+	funcDecl := &ast.FuncDecl{
 		Name: ast.NewIdent("recordInvocation"),
 		Type: &ast.FuncType{
 			Params: &ast.FieldList{
@@ -180,21 +1544,14 @@ func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 				},
 			},
 		},
-		Recv: &ast.FieldList{
-			List: []*ast.Field{
-				{
-					Names: []*ast.Ident{ast.NewIdent("fake")},
-					Type:  &ast.StarExpr{X: ast.NewIdent(structName)},
-				},
-			},
-		},
+		Recv: recv.fieldList(),
 		Body: &ast.BlockStmt{
 			List: []ast.Stmt{
 				&ast.ExprStmt{
 					X: &ast.CallExpr{
 						Fun: &ast.SelectorExpr{
 							X: &ast.SelectorExpr{
-								X:   ast.NewIdent("fake"),
+								X:   ast.NewIdent(recv.name),
 								Sel: ast.NewIdent("invocationsMutex"),
 							},
 							Sel: ast.NewIdent("Lock"),
@@ -205,7 +1562,7 @@ func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 					Call: &ast.CallExpr{
 						Fun: &ast.SelectorExpr{
 							X: &ast.SelectorExpr{
-								X:   ast.NewIdent("fake"),
+								X:   ast.NewIdent(recv.name),
 								Sel: ast.NewIdent("invocationsMutex"),
 							},
 							Sel: ast.NewIdent("Unlock"),
@@ -215,7 +1572,7 @@ func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 				&ast.IfStmt{
 					Cond: &ast.BinaryExpr{
 						X: &ast.SelectorExpr{
-							X:   ast.NewIdent("fake"),
+							X:   ast.NewIdent(recv.name),
 							Sel: ast.NewIdent("invocations"),
 						},
 						Op: token.EQL,
@@ -230,7 +1587,7 @@ func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 								Tok: token.ASSIGN,
 								Lhs: []ast.Expr{
 									&ast.SelectorExpr{
-										X:   ast.NewIdent("fake"),
+										X:   ast.NewIdent(recv.name),
 										Sel: ast.NewIdent("invocations"),
 									},
 								},
@@ -243,7 +1600,7 @@ func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 					Cond: &ast.BinaryExpr{
 						X: &ast.IndexExpr{
 							X: &ast.SelectorExpr{
-								X:   ast.NewIdent("fake"),
+								X:   ast.NewIdent(recv.name),
 								Sel: ast.NewIdent("invocations"),
 							},
 							Index: ast.NewIdent("key"),
@@ -261,7 +1618,7 @@ func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 								Lhs: []ast.Expr{
 									&ast.IndexExpr{
 										X: &ast.SelectorExpr{
-											X:   ast.NewIdent("fake"),
+											X:   ast.NewIdent(recv.name),
 											Sel: ast.NewIdent("invocations"),
 										},
 										Index: ast.NewIdent("key"),
@@ -278,7 +1635,7 @@ func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 					Lhs: []ast.Expr{
 						&ast.IndexExpr{
 							X: &ast.SelectorExpr{
-								X:   ast.NewIdent("fake"),
+								X:   ast.NewIdent(recv.name),
 								Sel: ast.NewIdent("invocations"),
 							},
 							Index: ast.NewIdent("key"),
@@ -290,7 +1647,7 @@ func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 							Args: []ast.Expr{
 								&ast.IndexExpr{
 									X: &ast.SelectorExpr{
-										X:   ast.NewIdent("fake"),
+										X:   ast.NewIdent(recv.name),
 										Sel: ast.NewIdent("invocations"),
 									},
 									Index: ast.NewIdent("key"),
@@ -302,19 +1659,439 @@ func addRecordInvocationMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 				},
 			},
 		},
+	}
+
+	if callType != "" {
+		// the entry is returned so that the results of the call can be
+		// recorded in it once there are some
+		funcDecl.Type.Results = &ast.FieldList{
+			List: []*ast.Field{{
+				Type: &ast.StarExpr{X: ast.NewIdent(callType)},
+			}},
+		}
+
+		funcDecl.Body.List = append(funcDecl.Body.List,
+			// fake.callSeq++
+			&ast.IncDecStmt{
+				X:   recv.field("callSeq"),
+				Tok: token.INC,
+			},
+			// call := &FakeMyStructCall{Method: key, Seq: fake.callSeq, Args: args}
+			&ast.AssignStmt{
+				Tok: token.DEFINE,
+				Lhs: []ast.Expr{ast.NewIdent("call")},
+				Rhs: []ast.Expr{
+					&ast.UnaryExpr{
+						Op: token.AND,
+						X: &ast.CompositeLit{
+							Type: ast.NewIdent(callType),
+							Elts: []ast.Expr{
+								&ast.KeyValueExpr{Key: ast.NewIdent("Method"), Value: ast.NewIdent("key")},
+								&ast.KeyValueExpr{Key: ast.NewIdent("Seq"), Value: recv.field("callSeq")},
+								&ast.KeyValueExpr{Key: ast.NewIdent("Args"), Value: ast.NewIdent("args")},
+							},
+						},
+					},
+				},
+			},
+			// fake.calls = append(fake.calls, call)
+			&ast.AssignStmt{
+				Tok: token.ASSIGN,
+				Lhs: []ast.Expr{recv.field("calls")},
+				Rhs: []ast.Expr{
+					&ast.CallExpr{
+						Fun:  ast.NewIdent("append"),
+						Args: []ast.Expr{recv.field("calls"), ast.NewIdent("call")},
+					},
+				},
+			},
+			// return call
+			&ast.ReturnStmt{
+				Results: []ast.Expr{ast.NewIdent("call")},
+			},
+		)
+	}
+
+	*funcDecls = append(*funcDecls, funcDecl)
+}
+
+// addResetMethod adds Reset, which forgets every call recorded so far,
+// taking each mutex in turn:
+//
+//	func (fake *FakeMyStruct) Reset() {
+//		fake.methodMutex.Lock()
+//		fake.methodArgsForCall = nil
+//		fake.methodResultsForCall = nil
+//		fake.methodMutex.Unlock()
+//		fake.invocationsMutex.Lock()
+//		fake.invocations = nil
+//		fake.calls = nil
+//		fake.invocationsMutex.Unlock()
+//	}
+//
+// callSeq is left as it is so that sequence numbers keep increasing.
+func addResetMethod(funcDecls *[]*ast.FuncDecl, methods []*ast.FuncDecl, recv receiver, callType string) {
+	var statements []ast.Stmt
+	for _, funcDecl := range methods {
+		privateName := fieldPrefix(funcDecl.Name.Name)
+		mutexName := privateName + "Mutex"
+
+		statements = append(statements,
+			&ast.ExprStmt{X: recv.mutexCall(mutexName, "Lock")},
+			assignNil(recv.field(privateName+"ArgsForCall")),
+		)
+		if funcDecl.Type.Results.NumFields() > 0 {
+			statements = append(statements, assignNil(recv.field(privateName+"ResultsForCall")))
+		}
+		statements = append(statements, &ast.ExprStmt{X: recv.mutexCall(mutexName, "Unlock")})
+	}
+
+	statements = append(statements,
+		&ast.ExprStmt{X: recv.mutexCall("invocationsMutex", "Lock")},
+		assignNil(recv.field("invocations")),
+	)
+	if callType != "" {
+		statements = append(statements, assignNil(recv.field("calls")))
+	}
+	statements = append(statements, &ast.ExprStmt{X: recv.mutexCall("invocationsMutex", "Unlock")})
+
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent("Reset"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: statements,
+		},
+	})
+}
+
+// addResetStubsMethod adds ResetStubs, which removes the stubs and canned
+// return values of every method:
+//
+//	func (fake *FakeMyStruct) ResetStubs() {
+//		fake.methodMutex.Lock()
+//		fake.MethodStub = nil
+//		fake.methodReturns = struct{ ... }{}
+//		fake.methodReturnsOnCall = nil
+//		fake.methodMutex.Unlock()
+//	}
+func addResetStubsMethod(funcDecls *[]*ast.FuncDecl, methods []*ast.FuncDecl, recv receiver, strict bool) {
+	var statements []ast.Stmt
+	for _, funcDecl := range methods {
+		privateName := fieldPrefix(funcDecl.Name.Name)
+		mutexName := privateName + "Mutex"
+
+		statements = append(statements,
+			&ast.ExprStmt{X: recv.mutexCall(mutexName, "Lock")},
+			assignNil(recv.field(funcDecl.Name.Name+"Stub")),
+		)
+		if funcDecl.Type.Results.NumFields() > 0 {
+			statements = append(statements,
+				&ast.AssignStmt{
+					Tok: token.ASSIGN,
+					Lhs: []ast.Expr{recv.field(privateName + "Returns")},
+					Rhs: []ast.Expr{
+						&ast.CompositeLit{Type: returnsStructType(funcDecl)},
+					},
+				},
+				assignNil(recv.field(privateName+"ReturnsOnCall")),
+			)
+			if strict {
+				statements = append(statements, &ast.AssignStmt{
+					Tok: token.ASSIGN,
+					Lhs: []ast.Expr{recv.field(privateName + "ReturnsSet")},
+					Rhs: []ast.Expr{ast.NewIdent("false")},
+				})
+			}
+		}
+		statements = append(statements, &ast.ExprStmt{X: recv.mutexCall(mutexName, "Unlock")})
+	}
+
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent("ResetStubs"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: statements,
+		},
+	})
+}
+
+// addFailUnstubbedMethod adds the method strict fakes call when a method is
+// called without a stub or return values:
+//
+//	func (fake *FakeMyStruct) failUnstubbed(method string, args []interface{}) {
+//		message := fmt.Sprintf("%s called with %v, but it has no stub or return values", method, args)
+//		if fake.FailUnstubbed != nil {
+//			fake.FailUnstubbed("%s", message)
+//			return
+//		}
+//		panic(message)
+//	}
+func addFailUnstubbedMethod(funcDecls *[]*ast.FuncDecl, recv receiver, fmtName string) {
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent("failUnstubbed"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{ast.NewIdent("method")},
+						Type:  ast.NewIdent("string"),
+					},
+					{
+						Names: []*ast.Ident{ast.NewIdent("args")},
+						Type:  ast.NewIdent("[]interface{}"),
+					},
+				},
+			},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				&ast.AssignStmt{
+					Tok: token.DEFINE,
+					Lhs: []ast.Expr{ast.NewIdent("message")},
+					Rhs: []ast.Expr{
+						&ast.CallExpr{
+							Fun: &ast.SelectorExpr{
+								X:   ast.NewIdent(fmtName),
+								Sel: ast.NewIdent("Sprintf"),
+							},
+							Args: []ast.Expr{
+								&ast.BasicLit{
+									Kind:  token.STRING,
+									Value: strconv.Quote("%s called with %v, but it has no stub or return values"),
+								},
+								ast.NewIdent("method"),
+								ast.NewIdent("args"),
+							},
+						},
+					},
+				},
+				&ast.IfStmt{
+					Cond: &ast.BinaryExpr{
+						X:  recv.field("FailUnstubbed"),
+						Op: token.NEQ,
+						Y:  ast.NewIdent("nil"),
+					},
+					Body: &ast.BlockStmt{
+						List: []ast.Stmt{
+							&ast.ExprStmt{
+								X: &ast.CallExpr{
+									Fun: recv.field("FailUnstubbed"),
+									Args: []ast.Expr{
+										&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote("%s")},
+										ast.NewIdent("message"),
+									},
+								},
+							},
+							&ast.ReturnStmt{},
+						},
+					},
+				},
+				&ast.ExprStmt{
+					X: &ast.CallExpr{
+						Fun:  ast.NewIdent("panic"),
+						Args: []ast.Expr{ast.NewIdent("message")},
+					},
+				},
+			},
+		},
+	})
+}
+
+func assignNil(lhs ast.Expr) ast.Stmt {
+	return &ast.AssignStmt{
+		Tok: token.ASSIGN,
+		Lhs: []ast.Expr{lhs},
+		Rhs: []ast.Expr{ast.NewIdent("nil")},
+	}
+}
+
+func addRecordResultsMethod(funcDecls *[]*ast.FuncDecl, recv receiver, callType string) {
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent("recordResults"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{ast.NewIdent("call")},
+						Type:  &ast.StarExpr{X: ast.NewIdent(callType)},
+					},
+					{
+						Names: []*ast.Ident{ast.NewIdent("results")},
+						Type:  ast.NewIdent("[]interface{}"),
+					},
+				},
+			},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				// fake.invocationsMutex.Lock()
+				&ast.ExprStmt{X: recv.mutexCall("invocationsMutex", "Lock")},
+				// defer fake.invocationsMutex.Unlock()
+				&ast.DeferStmt{Call: recv.mutexCall("invocationsMutex", "Unlock")},
+				// call.Results = results
+				&ast.AssignStmt{
+					Tok: token.ASSIGN,
+					Lhs: []ast.Expr{
+						&ast.SelectorExpr{X: ast.NewIdent("call"), Sel: ast.NewIdent("Results")},
+					},
+					Rhs: []ast.Expr{ast.NewIdent("results")},
+				},
+			},
+		},
+	})
+}
+
+// copyCallField returns the statement
+//
+//	copiedCalls[i].Field = append([]interface{}{}, call.Field...)
+func copyCallField(name string) ast.Stmt {
+	return &ast.AssignStmt{
+		Tok: token.ASSIGN,
+		Lhs: []ast.Expr{
+			&ast.SelectorExpr{
+				X:   &ast.IndexExpr{X: ast.NewIdent("copiedCalls"), Index: ast.NewIdent("i")},
+				Sel: ast.NewIdent(name),
+			},
+		},
+		Rhs: []ast.Expr{
+			&ast.CallExpr{
+				Fun: ast.NewIdent("append"),
+				Args: []ast.Expr{
+					ast.NewIdent("[]interface{}{}"),
+					&ast.SelectorExpr{X: ast.NewIdent("call"), Sel: ast.NewIdent(name)},
+				},
+				Ellipsis: 1,
+			},
+		},
+	}
+}
+
+// addCallType adds the declaration of the type of the entries in the log of
+// calls to genDecl, alongside the fake:
+//
+//	type FakeMyStructCall struct {
+//		Method  string
+//		Seq     int
+//		Args    []interface{}
+//		Results []interface{}
+//	}
+//
+// Results are empty until the call returns, and for methods without results.
+func addCallType(genDecl *ast.GenDecl, callType string) {
+	genDecl.Specs = append(genDecl.Specs, &ast.TypeSpec{
+		Name: ast.NewIdent(callType),
+		Type: &ast.StructType{
+			Fields: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{ast.NewIdent("Method")},
+						Type:  ast.NewIdent("string"),
+					},
+					{
+						Names: []*ast.Ident{ast.NewIdent("Seq")},
+						Type:  ast.NewIdent("int"),
+					},
+					{
+						Names: []*ast.Ident{ast.NewIdent("Args")},
+						Type:  ast.NewIdent("[]interface{}"),
+					},
+					{
+						Names: []*ast.Ident{ast.NewIdent("Results")},
+						Type:  ast.NewIdent("[]interface{}"),
+					},
+				},
+			},
+		},
 	})
 
-	*funcDecls = newFuncDecls
+	// the printer only groups the specs when there is a valid position
+	genDecl.Lparen = 1
 }
 
-func addInvocationsMethod(funcDecls *[]*ast.FuncDecl, structName string) {
+func addCallsMethod(funcDecls *[]*ast.FuncDecl, recv receiver, callType string) {
+	*funcDecls = append(*funcDecls, &ast.FuncDecl{
+		Name: ast.NewIdent("Calls"),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{},
+			Results: &ast.FieldList{
+				List: []*ast.Field{{
+					Type: &ast.ArrayType{Elt: ast.NewIdent(callType)},
+				}},
+			},
+		},
+		Recv: recv.fieldList(),
+		Body: &ast.BlockStmt{
+			List: []ast.Stmt{
+				// fake.invocationsMutex.RLock()
+				&ast.ExprStmt{X: recv.mutexCall("invocationsMutex", "RLock")},
+				// defer fake.invocationsMutex.RUnlock()
+				&ast.DeferStmt{Call: recv.mutexCall("invocationsMutex", "RUnlock")},
+				// copiedCalls := make([]FakeMyStructCall, len(fake.calls))
+				&ast.AssignStmt{
+					Tok: token.DEFINE,
+					Lhs: []ast.Expr{ast.NewIdent("copiedCalls")},
+					Rhs: []ast.Expr{
+						&ast.CallExpr{
+							Fun: ast.NewIdent("make"),
+							Args: []ast.Expr{
+								&ast.ArrayType{Elt: ast.NewIdent(callType)},
+								&ast.CallExpr{
+									Fun:  ast.NewIdent("len"),
+									Args: []ast.Expr{recv.field("calls")},
+								},
+							},
+						},
+					},
+				},
+				// for i, call := range fake.calls {
+				//   copiedCalls[i] = *call
+				//   copiedCalls[i].Args = append([]interface{}{}, call.Args...)
+				//   copiedCalls[i].Results = append([]interface{}{}, call.Results...)
+				// }
+				&ast.RangeStmt{
+					Key:   ast.NewIdent("i"),
+					Value: ast.NewIdent("call"),
+					Tok:   token.DEFINE,
+					X:     recv.field("calls"),
+					Body: &ast.BlockStmt{
+						List: []ast.Stmt{
+							&ast.AssignStmt{
+								Tok: token.ASSIGN,
+								Lhs: []ast.Expr{
+									&ast.IndexExpr{X: ast.NewIdent("copiedCalls"), Index: ast.NewIdent("i")},
+								},
+								Rhs: []ast.Expr{&ast.StarExpr{X: ast.NewIdent("call")}},
+							},
+							copyCallField("Args"),
+							copyCallField("Results"),
+						},
+					},
+				},
+				// return copiedCalls
+				&ast.ReturnStmt{
+					Results: []ast.Expr{ast.NewIdent("copiedCalls")},
+				},
+			},
+		},
+	})
+}
+
+func addInvocationsMethod(funcDecls *[]*ast.FuncDecl, recv receiver) {
 	statements := []ast.Stmt{
 		// fake.invocationsMutex.Lock()
 		&ast.ExprStmt{
 			X: &ast.CallExpr{
 				Fun: &ast.SelectorExpr{
 					X: &ast.SelectorExpr{
-						X:   ast.NewIdent("fake"),
+						X:   ast.NewIdent(recv.name),
 						Sel: ast.NewIdent("invocationsMutex"),
 					},
 					Sel: ast.NewIdent("RLock"),
@@ -326,7 +2103,7 @@ func addInvocationsMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 			Call: &ast.CallExpr{
 				Fun: &ast.SelectorExpr{
 					X: &ast.SelectorExpr{
-						X:   ast.NewIdent("fake"),
+						X:   ast.NewIdent(recv.name),
 						Sel: ast.NewIdent("invocationsMutex"),
 					},
 					Sel: ast.NewIdent("RUnlock"),
@@ -335,46 +2112,89 @@ func addInvocationsMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 		},
 	}
 
-	for _, funcDecl := range *funcDecls {
-		methodMutexFieldName := privatize(funcDecl.Name.Name) + "Mutex"
-
-		statements = append(statements,
-			// fake.methodMutex.RLock()
-			&ast.ExprStmt{
-				X: &ast.CallExpr{
-					Fun: &ast.SelectorExpr{
-						X: &ast.SelectorExpr{
-							X:   ast.NewIdent("fake"),
-							Sel: ast.NewIdent(methodMutexFieldName),
+	// every call is recorded under invocationsMutex alone, so holding it is
+	// enough for a consistent copy, whatever the number of methods, and the
+	// copy can be read while calls continue to be recorded
+	statements = append(statements,
+		// copiedInvocations := map[string][][]interface{}{}
+		&ast.AssignStmt{
+			Tok: token.DEFINE,
+			Lhs: []ast.Expr{ast.NewIdent("copiedInvocations")},
+			Rhs: []ast.Expr{ast.NewIdent("map[string][][]interface{}{}")},
+		},
+		// for key, value := range fake.invocations {
+		&ast.RangeStmt{
+			Key:   ast.NewIdent("key"),
+			Value: ast.NewIdent("value"),
+			Tok:   token.DEFINE,
+			X:     recv.field("invocations"),
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{
+					// copiedInvocations[key] = make([][]interface{}, len(value))
+					&ast.AssignStmt{
+						Tok: token.ASSIGN,
+						Lhs: []ast.Expr{
+							&ast.IndexExpr{
+								X:     ast.NewIdent("copiedInvocations"),
+								Index: ast.NewIdent("key"),
+							},
+						},
+						Rhs: []ast.Expr{
+							&ast.CallExpr{
+								Fun: ast.NewIdent("make"),
+								Args: []ast.Expr{
+									ast.NewIdent("[][]interface{}"),
+									&ast.CallExpr{
+										Fun:  ast.NewIdent("len"),
+										Args: []ast.Expr{ast.NewIdent("value")},
+									},
+								},
+							},
 						},
-						Sel: ast.NewIdent("RLock"),
 					},
-				},
-			},
-			// defer fake.methodMutex.RUnlock()
-			&ast.DeferStmt{
-				Call: &ast.CallExpr{
-					Fun: &ast.SelectorExpr{
-						X: &ast.SelectorExpr{
-							X:   ast.NewIdent("fake"),
-							Sel: ast.NewIdent(methodMutexFieldName),
+					// for i, args := range value {
+					//   copiedInvocations[key][i] = append([]interface{}{}, args...)
+					// }
+					&ast.RangeStmt{
+						Key:   ast.NewIdent("i"),
+						Value: ast.NewIdent("args"),
+						Tok:   token.DEFINE,
+						X:     ast.NewIdent("value"),
+						Body: &ast.BlockStmt{
+							List: []ast.Stmt{
+								&ast.AssignStmt{
+									Tok: token.ASSIGN,
+									Lhs: []ast.Expr{
+										&ast.IndexExpr{
+											X: &ast.IndexExpr{
+												X:     ast.NewIdent("copiedInvocations"),
+												Index: ast.NewIdent("key"),
+											},
+											Index: ast.NewIdent("i"),
+										},
+									},
+									Rhs: []ast.Expr{
+										&ast.CallExpr{
+											Fun: ast.NewIdent("append"),
+											Args: []ast.Expr{
+												ast.NewIdent("[]interface{}{}"),
+												ast.NewIdent("args"),
+											},
+											Ellipsis: 1,
+										},
+									},
+								},
+							},
 						},
-						Sel: ast.NewIdent("RUnlock"),
 					},
 				},
 			},
-		)
-	}
-
-	statements = append(statements, &ast.ReturnStmt{
-		// return fake.invocations
-		Results: []ast.Expr{
-			&ast.SelectorExpr{
-				X:   ast.NewIdent("fake"),
-				Sel: ast.NewIdent("invocations"),
-			},
 		},
-	})
+		// return copiedInvocations
+		&ast.ReturnStmt{
+			Results: []ast.Expr{ast.NewIdent("copiedInvocations")},
+		},
+	)
 
 	*funcDecls = append(*funcDecls, &ast.FuncDecl{
 		Name: ast.NewIdent("Invocations"),
@@ -386,14 +2206,7 @@ func addInvocationsMethod(funcDecls *[]*ast.FuncDecl, structName string) {
 				}},
 			},
 		},
-		Recv: &ast.FieldList{
-			List: []*ast.Field{
-				{
-					Names: []*ast.Ident{ast.NewIdent("fake")},
-					Type:  &ast.StarExpr{X: ast.NewIdent(structName)},
-				},
-			},
-		},
+		Recv: recv.fieldList(),
 		Body: &ast.BlockStmt{
 			List: statements,
 		},
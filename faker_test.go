@@ -2,14 +2,18 @@ package margarine_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/format"
+	"go/parser"
 	"go/token"
+	"strings"
 
 	"github.com/krishicks/margarine"
 	"github.com/krishicks/patrick"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -114,11 +118,12 @@ type MyInterface interface {
 		})
 
 		JustBeforeEach(func() {
-			margarine.Fakify(genDecl, &funcDecls)
+			err := margarine.Fakify(token.NewFileSet(), genDecl, &funcDecls)
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 		It("prepends 'Fake' to the struct name", func() {
-			Expect(genDecl.Specs).To(HaveLen(1))
+			Expect(genDecl.Specs).To(HaveLen(2))
 
 			typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
 			Expect(ok).To(BeTrue())
@@ -179,7 +184,7 @@ type MyInterface interface {
 
 		It("adds an invocations member to the struct", func() {
 			// invocations map[string][][]interface{}
-			Expect(genDecl.Specs).To(HaveLen(1))
+			Expect(genDecl.Specs).To(HaveLen(2))
 			typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
 			Expect(ok).To(BeTrue())
 
@@ -198,7 +203,7 @@ type MyInterface interface {
 
 		It("adds an invocationsMutex member to the struct", func() {
 			// invocationsMutex sync.RWMutex
-			Expect(genDecl.Specs).To(HaveLen(1))
+			Expect(genDecl.Specs).To(HaveLen(2))
 			typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
 			Expect(ok).To(BeTrue())
 
@@ -216,7 +221,7 @@ type MyInterface interface {
 		})
 
 		It("adds a recordInvocation method to the funcDecls", func() {
-			//  1: func (fake *FakeMyInterface) recordInvocation(key string, args []interface{}) {
+			//  1: func (fake *FakeMyInterface) recordInvocation(key string, args []interface{}) *FakeMyStructCall {
 			//  2:   fake.invocationsMutex.Lock()
 			//  3:   defer fake.invocationsMutex.Unlock()
 			//  4:   if fake.invocations == nil {
@@ -226,14 +231,12 @@ type MyInterface interface {
 			//  8:   	fake.invocations[key] = [][]interface{}{}
 			//  9:   }
 			// 10:   fake.invocations[key] = append(fake.invocations[key], args)
-			// 11: }
-			var funcDecl *ast.FuncDecl
-			for _, fn := range funcDecls {
-				if fn.Name.Name == "recordInvocation" {
-					funcDecl = fn
-					break
-				}
-			}
+			// 11:   fake.callSeq++
+			// 12:   call := &FakeMyStructCall{Method: key, Seq: fake.callSeq, Args: args}
+			// 13:   fake.calls = append(fake.calls, call)
+			// 14:   return call
+			// 15: }
+			funcDecl := findFuncDecl(funcDecls, "recordInvocation")
 
 			Expect(funcDecl).NotTo(BeNil())
 
@@ -251,10 +254,14 @@ type MyInterface interface {
 			Expect(recv).To(HaveLen(1))
 			Expect(recv[0].Names[0].Name).To(Equal("fake"))
 			Expect(recv[0].Type).To(Equal(&ast.StarExpr{X: ast.NewIdent("FakeMyStruct")}))
+
+			results := funcDecl.Type.Results.List
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Type).To(Equal(&ast.StarExpr{X: ast.NewIdent("FakeMyStructCall")}))
 			// end line 1
 
 			bodyList := funcDecl.Body.List
-			Expect(bodyList).To(HaveLen(5))
+			Expect(bodyList).To(HaveLen(9))
 
 			// line 2
 			line2, ok := bodyList[0].(*ast.ExprStmt)
@@ -264,18 +271,232 @@ type MyInterface interface {
 			Expect(ok).To(BeTrue())
 
 			///TODO: to be continued...
+
+			// line 11
+			line11, ok := bodyList[5].(*ast.IncDecStmt)
+			Expect(ok).To(BeTrue())
+			Expect(line11.X).To(Equal(&ast.SelectorExpr{
+				X:   ast.NewIdent("fake"),
+				Sel: ast.NewIdent("callSeq"),
+			}))
+
+			// line 12
+			line12, ok := bodyList[6].(*ast.AssignStmt)
+			Expect(ok).To(BeTrue())
+
+			unaryExpr, ok := line12.Rhs[0].(*ast.UnaryExpr)
+			Expect(ok).To(BeTrue())
+
+			compositeLit, ok := unaryExpr.X.(*ast.CompositeLit)
+			Expect(ok).To(BeTrue())
+			Expect(compositeLit.Type).To(Equal(ast.NewIdent("FakeMyStructCall")))
+
+			// line 14
+			line14, ok := bodyList[8].(*ast.ReturnStmt)
+			Expect(ok).To(BeTrue())
+			Expect(line14.Results).To(Equal([]ast.Expr{ast.NewIdent("call")}))
+		})
+
+		It("declares the type of the entries in the log of calls alongside the fake", func() {
+			// type FakeMyStructCall struct {
+			//   Method  string
+			//   Seq     int
+			//   Args    []interface{}
+			//   Results []interface{}
+			// }
+			typeSpec, ok := genDecl.Specs[1].(*ast.TypeSpec)
+			Expect(ok).To(BeTrue())
+			Expect(typeSpec.Name.Name).To(Equal("FakeMyStructCall"))
+
+			var buf bytes.Buffer
+			err := format.Node(&buf, token.NewFileSet(), genDecl)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(buf.String()).To(ContainSubstring(`		calls            []*FakeMyStructCall
+		callSeq          int
+`))
+			Expect(buf.String()).To(ContainSubstring(`	FakeMyStructCall struct {
+		Method  string
+		Seq     int
+		Args    []interface{}
+		Results []interface{}
+	}
+`))
+		})
+
+		It("adds a Calls method that returns a copy of the log of calls to the funcDecls", func() {
+			src := printFuncDecl(funcDecls, "Calls")
+
+			Expect(src).To(Equal(`func (fake *FakeMyStruct) Calls() []FakeMyStructCall {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedCalls := make([]FakeMyStructCall, len(fake.calls))
+	for i, call := range fake.calls {
+		copiedCalls[i] = *call
+		copiedCalls[i].Args = append([]interface{}{}, call.Args...)
+		copiedCalls[i].Results = append([]interface{}{}, call.Results...)
+	}
+	return copiedCalls
+}`))
+		})
+
+		It("implements the method on the fake", func() {
+			//  1: func (fake *FakeMyStruct) Method() {
+			//  2:   fake.methodMutex.Lock()
+			//  3:   stub := fake.MethodStub
+			//  4:   fake.methodArgsForCall = append(fake.methodArgsForCall, struct{}{})
+			//  5:   fake.methodMutex.Unlock()
+			//  6:   fake.recordInvocation("Method", []interface{}{})
+			//  7:   if stub != nil {
+			//  8:   	stub()
+			//  9:   }
+			// 10: }
+			funcDecl := findFuncDecl(funcDecls, "Method")
+
+			Expect(funcDecl).NotTo(BeNil())
+
+			recv := funcDecl.Recv.List
+			Expect(recv).To(HaveLen(1))
+			Expect(recv[0].Names[0].Name).To(Equal("fake"))
+			Expect(recv[0].Type).To(Equal(&ast.StarExpr{X: ast.NewIdent("FakeMyStruct")}))
+
+			bodyList := funcDecl.Body.List
+			Expect(bodyList).To(HaveLen(6))
+
+			// line 3
+			line3, ok := bodyList[1].(*ast.AssignStmt)
+			Expect(ok).To(BeTrue())
+			Expect(line3.Lhs).To(Equal([]ast.Expr{ast.NewIdent("stub")}))
+			Expect(line3.Rhs).To(Equal([]ast.Expr{&ast.SelectorExpr{
+				X:   ast.NewIdent("fake"),
+				Sel: ast.NewIdent("MethodStub"),
+			}}))
+
+			// line 4
+			line4, ok := bodyList[2].(*ast.AssignStmt)
+			Expect(ok).To(BeTrue())
+			Expect(line4.Lhs).To(Equal([]ast.Expr{&ast.SelectorExpr{
+				X:   ast.NewIdent("fake"),
+				Sel: ast.NewIdent("methodArgsForCall"),
+			}}))
+
+			// line 6
+			line6, ok := bodyList[4].(*ast.ExprStmt)
+			Expect(ok).To(BeTrue())
+
+			call, ok := line6.X.(*ast.CallExpr)
+			Expect(ok).To(BeTrue())
+			Expect(call.Fun).To(Equal(&ast.SelectorExpr{
+				X:   ast.NewIdent("fake"),
+				Sel: ast.NewIdent("recordInvocation"),
+			}))
+
+			// line 7
+			line7, ok := bodyList[5].(*ast.IfStmt)
+			Expect(ok).To(BeTrue())
+			Expect(line7.Cond).To(Equal(&ast.BinaryExpr{
+				X:  ast.NewIdent("stub"),
+				Op: token.NEQ,
+				Y:  ast.NewIdent("nil"),
+			}))
+			Expect(line7.Body.List).To(HaveLen(1))
+		})
+
+		It("adds a Calls method that sets the stub under the lock to the funcDecls", func() {
+			src := printFuncDecl(funcDecls, "MethodCalls")
+
+			Expect(src).To(Equal(`func (fake *FakeMyStruct) MethodCalls(stub func()) {
+	fake.methodMutex.Lock()
+	defer fake.methodMutex.Unlock()
+	fake.MethodStub = stub
+}`))
+		})
+
+		It("adds a CallCount method to the funcDecls", func() {
+			// 1: func (fake *FakeMyStruct) MethodCallCount() int {
+			// 2:   fake.methodMutex.RLock()
+			// 3:   defer fake.methodMutex.RUnlock()
+			// 4:   return len(fake.methodArgsForCall)
+			// 5: }
+			funcDecl := findFuncDecl(funcDecls, "MethodCallCount")
+
+			Expect(funcDecl).NotTo(BeNil())
+
+			Expect(funcDecl.Type.Params.List).To(BeEmpty())
+			Expect(funcDecl.Type.Results.List).To(HaveLen(1))
+			Expect(funcDecl.Type.Results.List[0].Type).To(Equal(ast.NewIdent("int")))
+
+			bodyList := funcDecl.Body.List
+			Expect(bodyList).To(HaveLen(3))
+
+			// line 4
+			line4, ok := bodyList[2].(*ast.ReturnStmt)
+			Expect(ok).To(BeTrue())
+			Expect(line4.Results).To(Equal([]ast.Expr{&ast.CallExpr{
+				Fun: ast.NewIdent("len"),
+				Args: []ast.Expr{&ast.SelectorExpr{
+					X:   ast.NewIdent("fake"),
+					Sel: ast.NewIdent("methodArgsForCall"),
+				}},
+			}}))
 		})
 
-		XIt("adds an Invocations method to the funcDecls", func() {
-			// implemented, just not tested
+		It("adds an Invocations method that returns a copy of the invocations to the funcDecls", func() {
+			src := printFuncDecl(funcDecls, "Invocations")
+
+			Expect(src).To(Equal(`func (fake *FakeMyStruct) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = make([][]interface{}, len(value))
+		for i, args := range value {
+			copiedInvocations[key][i] = append([]interface{}{}, args...)
+		}
+	}
+	return copiedInvocations
+}`))
 		})
 
-		XIt("does not contain a returns for each method that does not have return values", func() {
-			// implemented, just not tested
+		It("does not contain a returns for each method that does not have return values", func() {
+			Expect(genDecl.Specs).To(HaveLen(2))
+			typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
+			Expect(ok).To(BeTrue())
+
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			Expect(ok).To(BeTrue())
+
+			for _, f := range structType.Fields.List {
+				for _, name := range f.Names {
+					Expect(name.Name).NotTo(Equal("methodReturns"))
+					Expect(name.Name).NotTo(Equal("methodReturnsOnCall"))
+				}
+			}
+
+			for _, fn := range funcDecls {
+				Expect(fn.Name.Name).NotTo(Equal("MethodReturns"))
+				Expect(fn.Name.Name).NotTo(Equal("MethodReturnsOnCall"))
+			}
 		})
 
-		XIt("does not contain an argsForCall for each method that does not have params", func() {
-			// implemented, just not tested
+		It("adds an empty ArgsForCall struct member for each method that does not have params", func() {
+			// methodArgsForCall []struct{}
+			Expect(genDecl.Specs).To(HaveLen(2))
+			typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
+			Expect(ok).To(BeTrue())
+
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			Expect(ok).To(BeTrue())
+
+			Expect(structType.Fields).NotTo(BeNil())
+			Expect(structType.Fields.List).To(ContainElement(&ast.Field{
+				Names: []*ast.Ident{ast.NewIdent("methodArgsForCall")},
+				Type: &ast.ArrayType{
+					Elt: &ast.StructType{
+						Fields: &ast.FieldList{},
+					},
+				},
+			}))
 		})
 
 		Context("when a function in the interface takes params with an ellipsis", func() {
@@ -296,7 +517,7 @@ type MyInterface interface {
 				// methodArgsForCall []struct {
 				//   arg1 ...string
 				// }
-				Expect(genDecl.Specs).To(HaveLen(1))
+				Expect(genDecl.Specs).To(HaveLen(2))
 				typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
 				Expect(ok).To(BeTrue())
 
@@ -334,6 +555,143 @@ type MyInterface interface {
 				Expect(ok).To(BeTrue())
 				Expect(ident.Name).To(Equal("string"))
 			})
+
+			It("keeps the ellipsis in the stub signature", func() {
+				// MethodStub func(...string)
+				typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
+				Expect(ok).To(BeTrue())
+
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				Expect(ok).To(BeTrue())
+
+				var field *ast.Field
+				for _, f := range structType.Fields.List {
+					if f.Names[0].Name == "MethodStub" {
+						field = f
+						break
+					}
+				}
+
+				Expect(field).NotTo(BeNil())
+
+				funcType, ok := field.Type.(*ast.FuncType)
+				Expect(ok).To(BeTrue())
+				Expect(funcType.Params.List).To(HaveLen(1))
+
+				ellipsis, ok := funcType.Params.List[0].Type.(*ast.Ellipsis)
+				Expect(ok).To(BeTrue())
+
+				ident, ok := ellipsis.Elt.(*ast.Ident)
+				Expect(ok).To(BeTrue())
+				Expect(ident.Name).To(Equal("string"))
+			})
+
+			It("records a copy of the variadic arg and passes the original on to the stub", func() {
+				//  1: func (fake *FakeMyStruct) Method(arg1 ...string) {
+				//  2:   var arg1Copy []string
+				//  3:   if arg1 != nil {
+				//  4:     arg1Copy = make([]string, len(arg1))
+				//  5:     copy(arg1Copy, arg1)
+				//  6:   }
+				//  7:   fake.methodMutex.Lock()
+				//  8:   stub := fake.MethodStub
+				//  9:   fake.methodArgsForCall = append(fake.methodArgsForCall, struct {
+				// 10:     arg1 []string
+				// 11:   }{arg1Copy})
+				// 12:   fake.methodMutex.Unlock()
+				// 13:   fake.recordInvocation("Method", []interface{}{arg1Copy})
+				// 14:   if stub != nil {
+				// 15:     stub(arg1...)
+				// 16:   }
+				// 17: }
+				funcDecl := findFuncDecl(funcDecls, "Method")
+
+				Expect(funcDecl).NotTo(BeNil())
+
+				bodyList := funcDecl.Body.List
+				Expect(bodyList).To(HaveLen(8))
+
+				// line 2
+				_, ok := bodyList[0].(*ast.DeclStmt)
+				Expect(ok).To(BeTrue())
+
+				// line 9
+				line9, ok := bodyList[4].(*ast.AssignStmt)
+				Expect(ok).To(BeTrue())
+
+				appendCall, ok := line9.Rhs[0].(*ast.CallExpr)
+				Expect(ok).To(BeTrue())
+
+				compositeLit, ok := appendCall.Args[1].(*ast.CompositeLit)
+				Expect(ok).To(BeTrue())
+				Expect(compositeLit.Elts).To(Equal([]ast.Expr{ast.NewIdent("arg1Copy")}))
+
+				// line 15
+				line14, ok := bodyList[7].(*ast.IfStmt)
+				Expect(ok).To(BeTrue())
+
+				line15, ok := line14.Body.List[0].(*ast.ExprStmt)
+				Expect(ok).To(BeTrue())
+
+				stubCall, ok := line15.X.(*ast.CallExpr)
+				Expect(ok).To(BeTrue())
+				Expect(stubCall.Args).To(Equal([]ast.Expr{ast.NewIdent("arg1")}))
+				Expect(stubCall.Ellipsis.IsValid()).To(BeTrue())
+			})
+
+			It("returns the variadic arg as a slice from the ArgsForCall method", func() {
+				// func (fake *FakeMyStruct) MethodArgsForCall(i int) []string
+				funcDecl := findFuncDecl(funcDecls, "MethodArgsForCall")
+
+				Expect(funcDecl).NotTo(BeNil())
+
+				results := funcDecl.Type.Results.List
+				Expect(results).To(HaveLen(1))
+
+				arrayType, ok := results[0].Type.(*ast.ArrayType)
+				Expect(ok).To(BeTrue())
+
+				ident, ok := arrayType.Elt.(*ast.Ident)
+				Expect(ok).To(BeTrue())
+				Expect(ident.Name).To(Equal("string"))
+			})
+		})
+
+		Context("when a function in the interface takes a variadic func as a param", func() {
+			BeforeEach(func() {
+				src := []byte(`
+package mypackage
+
+type MyInterface interface {
+	Method(func(...string) error)
+}
+`)
+				var err error
+				genDecl, funcDecls, err = patrick.Pour(src, "MyInterface", "MyStruct")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("keeps the variadic func type intact", func() {
+				// methodArgsForCall []struct {
+				//   arg1 func(...string) error
+				// }
+				typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
+				Expect(ok).To(BeTrue())
+
+				var buf bytes.Buffer
+				err := format.Node(&buf, token.NewFileSet(), typeSpec)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(buf.String()).To(ContainSubstring("MethodStub        func(func(...string) error)"))
+				Expect(buf.String()).To(ContainSubstring("arg1 func(...string) error"))
+			})
+
+			It("does not forward the param with an ellipsis", func() {
+				src := printFuncDecl(funcDecls, "Method")
+
+				Expect(src).To(ContainSubstring("func (fake *FakeMyStruct) Method(arg1 func(...string) error) {"))
+				Expect(src).To(ContainSubstring("stub(arg1)\n"))
+			})
 		})
 
 		Context("when a function in the interface has params", func() {
@@ -356,7 +714,7 @@ type MyInterface interface {
 				//   arg1 int
 				//   arg2 string
 				// }
-				Expect(genDecl.Specs).To(HaveLen(1))
+				Expect(genDecl.Specs).To(HaveLen(2))
 				typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
 				Expect(ok).To(BeTrue())
 
@@ -402,6 +760,51 @@ type MyInterface interface {
 				Expect(fields[1].Names).NotTo(BeNil())
 				Expect(fields[1].Names[0].Name).To(Equal("arg2"))
 			})
+
+			It("adds an ArgsForCall method to the funcDecls", func() {
+				// 1: func (fake *FakeMyStruct) MethodArgsForCall(i int) (int, string) {
+				// 2:   fake.methodMutex.RLock()
+				// 3:   defer fake.methodMutex.RUnlock()
+				// 4:   return fake.methodArgsForCall[i].arg1, fake.methodArgsForCall[i].arg2
+				// 5: }
+				funcDecl := findFuncDecl(funcDecls, "MethodArgsForCall")
+
+				Expect(funcDecl).NotTo(BeNil())
+
+				params := funcDecl.Type.Params.List
+				Expect(params).To(HaveLen(1))
+				Expect(params[0].Names[0].Name).To(Equal("i"))
+				Expect(params[0].Type).To(Equal(ast.NewIdent("int")))
+
+				results := funcDecl.Type.Results.List
+				Expect(results).To(HaveLen(2))
+
+				ident, ok := results[0].Type.(*ast.Ident)
+				Expect(ok).To(BeTrue())
+				Expect(ident.Name).To(Equal("int"))
+
+				ident, ok = results[1].Type.(*ast.Ident)
+				Expect(ok).To(BeTrue())
+				Expect(ident.Name).To(Equal("string"))
+
+				bodyList := funcDecl.Body.List
+				Expect(bodyList).To(HaveLen(3))
+
+				// line 4
+				line4, ok := bodyList[2].(*ast.ReturnStmt)
+				Expect(ok).To(BeTrue())
+				Expect(line4.Results).To(HaveLen(2))
+				Expect(line4.Results[1]).To(Equal(&ast.SelectorExpr{
+					X: &ast.IndexExpr{
+						X: &ast.SelectorExpr{
+							X:   ast.NewIdent("fake"),
+							Sel: ast.NewIdent("methodArgsForCall"),
+						},
+						Index: ast.NewIdent("i"),
+					},
+					Sel: ast.NewIdent("arg2"),
+				}))
+			})
 		})
 
 		Context("when a function in the interface has return values", func() {
@@ -424,7 +827,7 @@ type MyInterface interface {
 				//   result1 int
 				//   result2 error
 				// }
-				Expect(genDecl.Specs).To(HaveLen(1))
+				Expect(genDecl.Specs).To(HaveLen(2))
 				typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
 				Expect(ok).To(BeTrue())
 
@@ -465,6 +868,990 @@ type MyInterface interface {
 				Expect(returnsFields[1].Names).NotTo(BeNil())
 				Expect(returnsFields[1].Names[0].Name).To(Equal("result2"))
 			})
+
+			It("returns the results of the call from the method", func() {
+				// return results.result1, results.result2
+				funcDecl := findFuncDecl(funcDecls, "Method")
+
+				Expect(funcDecl).NotTo(BeNil())
+
+				bodyList := funcDecl.Body.List
+				returnStmt, ok := bodyList[len(bodyList)-1].(*ast.ReturnStmt)
+				Expect(ok).To(BeTrue())
+
+				Expect(returnStmt.Results).To(Equal([]ast.Expr{
+					&ast.SelectorExpr{
+						X:   ast.NewIdent("results"),
+						Sel: ast.NewIdent("result1"),
+					},
+					&ast.SelectorExpr{
+						X:   ast.NewIdent("results"),
+						Sel: ast.NewIdent("result2"),
+					},
+				}))
+			})
+
+			It("records the results of the call, from the stub or the canned return values", func() {
+				src := printFuncDecl(funcDecls, "Method")
+
+				Expect(src).To(ContainSubstring(`	call := fake.recordInvocation("Method", []interface{}{})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1, results.result2 = stub()
+	}
+	fake.methodMutex.Lock()
+	if fake.methodResultsForCall == nil {
+		fake.methodResultsForCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.methodResultsForCall[callIndex] = results
+	fake.methodMutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1, results.result2})
+`))
+			})
+
+			It("adds a ResultsForCall method to the funcDecls", func() {
+				src := printFuncDecl(funcDecls, "MethodResultsForCall")
+
+				Expect(src).To(Equal(`func (fake *FakeMyStruct) MethodResultsForCall(i int) (int, error) {
+	fake.methodMutex.RLock()
+	defer fake.methodMutex.RUnlock()
+	return fake.methodResultsForCall[i].result1, fake.methodResultsForCall[i].result2
+}`))
+			})
+
+			It("adds a recordResults method to the funcDecls", func() {
+				src := printFuncDecl(funcDecls, "recordResults")
+
+				Expect(src).To(Equal(`func (fake *FakeMyStruct) recordResults(call *FakeMyStructCall, results []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	call.Results = results
+}`))
+			})
+
+			It("adds a returnsOnCall member for each method with return values to the struct", func() {
+				// methodReturnsOnCall map[int]struct {
+				//   result1 int
+				//   result2 error
+				// }
+				Expect(genDecl.Specs).To(HaveLen(2))
+				typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
+				Expect(ok).To(BeTrue())
+
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				Expect(ok).To(BeTrue())
+
+				var field *ast.Field
+				for _, f := range structType.Fields.List {
+					for _, name := range f.Names {
+						if name.Name == "methodReturnsOnCall" {
+							field = f
+							break
+						}
+					}
+				}
+
+				Expect(field).NotTo(BeNil())
+
+				mapType, ok := field.Type.(*ast.MapType)
+				Expect(ok).To(BeTrue())
+				Expect(mapType.Key).To(Equal(ast.NewIdent("int")))
+
+				valueStructType, ok := mapType.Value.(*ast.StructType)
+				Expect(ok).To(BeTrue())
+				Expect(valueStructType.Fields.List).To(HaveLen(2))
+			})
+
+			It("adds a Returns method to the funcDecls", func() {
+				// 1: func (fake *FakeMyStruct) MethodReturns(result1 int, result2 error) {
+				// 2:   fake.methodMutex.Lock()
+				// 3:   defer fake.methodMutex.Unlock()
+				// 4:   fake.MethodStub = nil
+				// 5:   fake.methodReturns = struct {
+				// 6:     result1 int
+				// 7:     result2 error
+				// 8:   }{result1, result2}
+				// 9: }
+				funcDecl := findFuncDecl(funcDecls, "MethodReturns")
+
+				Expect(funcDecl).NotTo(BeNil())
+
+				params := funcDecl.Type.Params.List
+				Expect(params).To(HaveLen(2))
+				Expect(params[0].Names[0].Name).To(Equal("result1"))
+				Expect(params[1].Names[0].Name).To(Equal("result2"))
+
+				bodyList := funcDecl.Body.List
+				Expect(bodyList).To(HaveLen(4))
+
+				// line 5
+				line5, ok := bodyList[3].(*ast.AssignStmt)
+				Expect(ok).To(BeTrue())
+				Expect(line5.Lhs).To(Equal([]ast.Expr{&ast.SelectorExpr{
+					X:   ast.NewIdent("fake"),
+					Sel: ast.NewIdent("methodReturns"),
+				}}))
+			})
+
+			It("adds a ReturnsOnCall method to the funcDecls", func() {
+				//  1: func (fake *FakeMyStruct) MethodReturnsOnCall(i int, result1 int, result2 error) {
+				//  2:   fake.methodMutex.Lock()
+				//  3:   defer fake.methodMutex.Unlock()
+				//  4:   fake.MethodStub = nil
+				//  5:   if fake.methodReturnsOnCall == nil {
+				//  6:     fake.methodReturnsOnCall = make(map[int]struct {
+				//  7:       result1 int
+				//  8:       result2 error
+				//  9:     })
+				// 10:   }
+				// 11:   fake.methodReturnsOnCall[i] = struct {
+				// 12:     result1 int
+				// 13:     result2 error
+				// 14:   }{result1, result2}
+				// 15: }
+				funcDecl := findFuncDecl(funcDecls, "MethodReturnsOnCall")
+
+				Expect(funcDecl).NotTo(BeNil())
+
+				params := funcDecl.Type.Params.List
+				Expect(params).To(HaveLen(3))
+				Expect(params[0].Names[0].Name).To(Equal("i"))
+				Expect(params[0].Type).To(Equal(ast.NewIdent("int")))
+				Expect(params[1].Names[0].Name).To(Equal("result1"))
+				Expect(params[2].Names[0].Name).To(Equal("result2"))
+
+				bodyList := funcDecl.Body.List
+				Expect(bodyList).To(HaveLen(5))
+
+				// line 11
+				line11, ok := bodyList[4].(*ast.AssignStmt)
+				Expect(ok).To(BeTrue())
+				Expect(line11.Lhs).To(Equal([]ast.Expr{&ast.IndexExpr{
+					X: &ast.SelectorExpr{
+						X:   ast.NewIdent("fake"),
+						Sel: ast.NewIdent("methodReturnsOnCall"),
+					},
+					Index: ast.NewIdent("i"),
+				}}))
+			})
+		})
+	})
+
+	Describe("Fakify with the Simple interface", func() {
+		var (
+			structType *ast.StructType
+			funcDecls  []*ast.FuncDecl
+		)
+
+		var findField = func(name string) *ast.Field {
+			for _, f := range structType.Fields.List {
+				for _, n := range f.Names {
+					if n.Name == name {
+						return f
+					}
+				}
+			}
+			return nil
+		}
+
+		BeforeEach(func() {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "notes.go", nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			var typeSpec *ast.TypeSpec
+			for _, decl := range file.Decls {
+				if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.TYPE {
+					typeSpec = genDecl.Specs[0].(*ast.TypeSpec)
+				}
+			}
+			Expect(typeSpec).NotTo(BeNil())
+
+			methods, _, err := margarine.Flatten(fset, []*ast.File{file}, file, typeSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			var genDecl *ast.GenDecl
+			genDecl, funcDecls = margarine.StructFromMethods("Simple", nil, methods)
+
+			err = margarine.Fakify(fset, genDecl, &funcDecls)
+			Expect(err).NotTo(HaveOccurred())
+
+			structType = genDecl.Specs[0].(*ast.TypeSpec).Type.(*ast.StructType)
+		})
+
+		DescribeTable("expands each param and result to one per position",
+			func(method string, numParams int, numResults int) {
+				privateName := strings.ToLower(method)
+
+				// AStub func(int, int, string, int) (int, int)
+				stub := findField(method + "Stub")
+				Expect(stub).NotTo(BeNil())
+				stubType, ok := stub.Type.(*ast.FuncType)
+				Expect(ok).To(BeTrue())
+				Expect(stubType.Params.List).To(HaveLen(numParams))
+				Expect(stubType.Results.List).To(HaveLen(numResults))
+
+				// aArgsForCall []struct{ arg1 int; ... }
+				argsForCall := findField(privateName + "ArgsForCall")
+				Expect(argsForCall).NotTo(BeNil())
+				argsStructType := argsForCall.Type.(*ast.ArrayType).Elt.(*ast.StructType)
+				Expect(argsStructType.Fields.List).To(HaveLen(numParams))
+
+				// func (fake *FakeSimple) A(arg1 int, arg2 int, arg3 string, arg4 int) (int, int)
+				funcDecl := findFuncDecl(funcDecls, method)
+				Expect(funcDecl).NotTo(BeNil())
+				Expect(funcDecl.Type.Params.List).To(HaveLen(numParams))
+				for i, param := range funcDecl.Type.Params.List {
+					Expect(param.Names).To(HaveLen(1))
+					Expect(param.Names[0].Name).To(Equal(fmt.Sprintf("arg%d", i+1)))
+				}
+				Expect(funcDecl.Type.Results.List).To(HaveLen(numResults))
+
+				if numResults == 0 {
+					Expect(findField(privateName + "Returns")).To(BeNil())
+					return
+				}
+
+				// aReturns struct{ result1 int; result2 int }
+				returns := findField(privateName + "Returns")
+				Expect(returns).NotTo(BeNil())
+				Expect(returns.Type.(*ast.StructType).Fields.List).To(HaveLen(numResults))
+
+				// return fakeReturns.result1, fakeReturns.result2
+				bodyList := funcDecl.Body.List
+				returnStmt, ok := bodyList[len(bodyList)-1].(*ast.ReturnStmt)
+				Expect(ok).To(BeTrue())
+				Expect(returnStmt.Results).To(HaveLen(numResults))
+			},
+			Entry("grouped params and results", "A", 4, 2),
+			Entry("unnamed params", "B", 3, 1),
+			Entry("unnamed params without results", "C", 3, 0),
+			Entry("blank params and results", "D", 3, 2),
+		)
+	})
+
+	Describe("FakifyWithOpts", func() {
+		var (
+			genDecl   *ast.GenDecl
+			funcDecls []*ast.FuncDecl
+			opts      margarine.FakifyOpts
+		)
+
+		var funcDeclNames = func() []string {
+			var names []string
+			for _, fn := range funcDecls {
+				names = append(names, fn.Name.Name)
+			}
+			return names
+		}
+
+		BeforeEach(func() {
+			src := []byte(`
+package mypackage
+
+type MyInterface interface {
+	Method(int) error
+}
+`)
+
+			var err error
+			genDecl, funcDecls, err = patrick.Pour(src, "MyInterface", "MyStruct")
+			Expect(err).NotTo(HaveOccurred())
+
+			opts = margarine.FakifyOpts{}
+		})
+
+		JustBeforeEach(func() {
+			err := margarine.FakifyWithOpts(token.NewFileSet(), genDecl, &funcDecls, opts)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when StructName is set", func() {
+			BeforeEach(func() {
+				opts.StructName = "MySpecialFake"
+				opts.Prefix = "Ignored"
+			})
+
+			It("names the fake StructName", func() {
+				typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
+				Expect(ok).To(BeTrue())
+				Expect(typeSpec.Name.Name).To(Equal("MySpecialFake"))
+			})
+		})
+
+		Context("when a Suffix is set", func() {
+			BeforeEach(func() {
+				opts.Suffix = "Spy"
+			})
+
+			It("does not prepend 'Fake' to the struct name", func() {
+				typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
+				Expect(ok).To(BeTrue())
+				Expect(typeSpec.Name.Name).To(Equal("MyStructSpy"))
+			})
+		})
+
+		Context("when a ReceiverName is set", func() {
+			BeforeEach(func() {
+				opts.ReceiverName = "spy"
+			})
+
+			It("uses it as the receiver of every method", func() {
+				for _, fn := range funcDecls {
+					Expect(fn.Recv.List[0].Names[0].Name).To(Equal("spy"))
+				}
+			})
+
+			It("refers to the receiver in the method bodies", func() {
+				// spy.methodMutex.Lock()
+				Expect(funcDecls[0].Name.Name).To(Equal("Method"))
+
+				line, ok := funcDecls[0].Body.List[0].(*ast.ExprStmt)
+				Expect(ok).To(BeTrue())
+				Expect(line.X).To(Equal(&ast.CallExpr{
+					Fun: &ast.SelectorExpr{
+						X: &ast.SelectorExpr{
+							X:   ast.NewIdent("spy"),
+							Sel: ast.NewIdent("methodMutex"),
+						},
+						Sel: ast.NewIdent("Lock"),
+					},
+				}))
+			})
+		})
+
+		Context("when the fake is reset", func() {
+			var funcDecl = func(name string) string {
+				return printFuncDecl(funcDecls, name)
+			}
+
+			It("forgets the recorded calls under each mutex in turn", func() {
+				Expect(funcDecl("Reset")).To(Equal(`func (fake *FakeMyStruct) Reset() {
+	fake.methodMutex.Lock()
+	fake.methodArgsForCall = nil
+	fake.methodResultsForCall = nil
+	fake.methodMutex.Unlock()
+	fake.invocationsMutex.Lock()
+	fake.invocations = nil
+	fake.calls = nil
+	fake.invocationsMutex.Unlock()
+}`))
+			})
+
+			It("removes the stubs and canned return values under the method mutexes", func() {
+				Expect(funcDecl("ResetStubs")).To(Equal(`func (fake *FakeMyStruct) ResetStubs() {
+	fake.methodMutex.Lock()
+	fake.MethodStub = nil
+	fake.methodReturns = struct {
+		result1 error
+	}{}
+	fake.methodReturnsOnCall = nil
+	fake.methodMutex.Unlock()
+}`))
+			})
+
+			Context("when there is no log of calls", func() {
+				BeforeEach(func() {
+					opts.OmitCalls = true
+				})
+
+				It("does not reset it", func() {
+					Expect(funcDecl("Reset")).NotTo(ContainSubstring("fake.calls"))
+				})
+			})
+		})
+
+		Context("when Strict is set", func() {
+			BeforeEach(func() {
+				opts.Strict = true
+			})
+
+			var funcDecl = func(name string) string {
+				return printFuncDecl(funcDecls, name)
+			}
+
+			It("adds a FailUnstubbed member and a returnsSet member for each method with return values", func() {
+				var buf bytes.Buffer
+				err := format.Node(&buf, token.NewFileSet(), genDecl)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(buf.String()).To(ContainSubstring("		methodReturnsSet    bool\n"))
+				Expect(buf.String()).To(ContainSubstring("		FailUnstubbed    func(format string, args ...interface{})\n"))
+			})
+
+			It("fails a call without a stub or return values", func() {
+				Expect(funcDecl("Method")).To(ContainSubstring(`	returnsSet := fake.methodReturnsSet
+`))
+				Expect(funcDecl("Method")).To(ContainSubstring(`	if stub == nil && !specificReturn && !returnsSet {
+		fake.failUnstubbed("Method", []interface{}{arg1})
+	}
+`))
+			})
+
+			It("adds a failUnstubbed method to the funcDecls", func() {
+				Expect(funcDecl("failUnstubbed")).To(Equal(`func (fake *FakeMyStruct) failUnstubbed(method string, args []interface{}) {
+	message := fmt.Sprintf("%s called with %v, but it has no stub or return values", method, args)
+	if fake.FailUnstubbed != nil {
+		fake.FailUnstubbed("%s", message)
+		return
+	}
+	panic(message)
+}`))
+			})
+
+			It("records that the return values are set in the Returns method", func() {
+				Expect(funcDecl("MethodReturns")).To(ContainSubstring("	fake.methodReturnsSet = true\n"))
+			})
+
+			It("forgets that the return values are set in the ResetStubs method", func() {
+				Expect(funcDecl("ResetStubs")).To(ContainSubstring("	fake.methodReturnsSet = false\n"))
+			})
+
+			Context("when a method has no results", func() {
+				BeforeEach(func() {
+					src := []byte(`
+package mypackage
+
+type MyInterface interface {
+	Close(int)
+}
+`)
+
+					var err error
+					genDecl, funcDecls, err = patrick.Pour(src, "MyInterface", "MyStruct")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("fails a call without a stub", func() {
+					Expect(funcDecl("Close")).To(ContainSubstring(`	if stub != nil {
+		stub(arg1)
+	} else {
+		fake.failUnstubbed("Close", []interface{}{arg1})
+	}
+`))
+				})
+
+				It("adds the failUnstubbed method and FailUnstubbed member", func() {
+					Expect(findFuncDecl(funcDecls, "failUnstubbed")).NotTo(BeNil())
+
+					var buf bytes.Buffer
+					err := format.Node(&buf, token.NewFileSet(), genDecl)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(buf.String()).To(MatchRegexp(`\tFailUnstubbed +func\(format string, args \.\.\.interface\{\}\)\n`))
+				})
+			})
+		})
+
+		Context("when Strict is not set", func() {
+			It("does not add the failUnstubbed method or FailUnstubbed member", func() {
+				Expect(findFuncDecl(funcDecls, "failUnstubbed")).To(BeNil())
+
+				var buf bytes.Buffer
+				err := format.Node(&buf, token.NewFileSet(), genDecl)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buf.String()).NotTo(ContainSubstring("FailUnstubbed"))
+			})
+
+			It("does not fail a call without a stub or return values", func() {
+				Expect(printFuncDecl(funcDecls, "Method")).NotTo(ContainSubstring("failUnstubbed"))
+			})
+		})
+
+		Context("when the optional methods are omitted", func() {
+			BeforeEach(func() {
+				opts.OmitCallCount = true
+				opts.OmitArgsForCall = true
+				opts.OmitResultsForCall = true
+				opts.OmitStubSetters = true
+				opts.OmitReturns = true
+				opts.OmitInvocations = true
+				opts.OmitReset = true
+				opts.OmitCalls = true
+			})
+
+			It("only generates the interface's methods and recordInvocation", func() {
+				Expect(funcDeclNames()).To(Equal([]string{"Method", "recordInvocation"}))
+			})
+
+			It("only declares the fake", func() {
+				Expect(genDecl.Specs).To(HaveLen(1))
+			})
+		})
+
+		Context("when nothing is omitted", func() {
+			It("generates every optional method", func() {
+				Expect(funcDeclNames()).To(Equal([]string{
+					"Method",
+					"MethodCallCount",
+					"MethodArgsForCall",
+					"MethodResultsForCall",
+					"MethodCalls",
+					"MethodReturns",
+					"MethodReturnsOnCall",
+					"Invocations",
+					"Calls",
+					"Reset",
+					"ResetStubs",
+					"recordInvocation",
+					"recordResults",
+				}))
+			})
+		})
+	})
+
+	Describe("Fakify with input it cannot fake", func() {
+		It("returns a NotAStructError when the declaration is not a struct", func() {
+			src := []byte(`
+package mypackage
+
+type MyStruct int
+`)
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "src.go", src, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			genDecl := file.Decls[0].(*ast.GenDecl)
+			funcDecls := []*ast.FuncDecl{}
+
+			err = margarine.Fakify(fset, genDecl, &funcDecls)
+			Expect(err).To(MatchError("src.go:4:6: MyStruct: MyStruct is not a struct"))
+
+			var notAStructErr *margarine.NotAStructError
+			Expect(errors.As(err, &notAStructErr)).To(BeTrue())
+			Expect(notAStructErr.Name).To(Equal("MyStruct"))
+
+			var fakifyErr *margarine.Error
+			Expect(errors.As(err, &fakifyErr)).To(BeTrue())
+			Expect(fakifyErr.Pos.Line).To(Equal(4))
+		})
+
+		It("returns an UnsupportedError when the declaration is not a type", func() {
+			genDecl := &ast.GenDecl{Tok: token.VAR}
+			funcDecls := []*ast.FuncDecl{}
+
+			err := margarine.Fakify(token.NewFileSet(), genDecl, &funcDecls)
+			Expect(err).To(MatchError("unsupported var declaration with 0 specs"))
+
+			var unsupportedErr *margarine.UnsupportedError
+			Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+		})
+
+		It("returns an UnsupportedError naming the first type when the declaration has more than one", func() {
+			src := []byte(`
+package mypackage
+
+type (
+	MyStruct struct{}
+	Other    struct{}
+)
+`)
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "src.go", src, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			genDecl := file.Decls[0].(*ast.GenDecl)
+			funcDecls := []*ast.FuncDecl{}
+
+			err = margarine.Fakify(fset, genDecl, &funcDecls)
+			Expect(err).To(MatchError("src.go:4:1: MyStruct: unsupported type declaration with 2 specs"))
+		})
+
+		DescribeTable("returns an UnsupportedError when the ReceiverName would break the fake",
+			func(receiverName string, message string) {
+				src := []byte(`
+package mypackage
+
+import "io"
+
+type MyInterface[T any] interface {
+	Method(w io.Writer, t T) error
+}
+`)
+
+				fset := token.NewFileSet()
+				file, err := parser.ParseFile(fset, "src.go", src, 0)
+				Expect(err).NotTo(HaveOccurred())
+
+				typeSpec := file.Decls[1].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+				methods, _, err := margarine.Flatten(fset, []*ast.File{file}, file, typeSpec)
+				Expect(err).NotTo(HaveOccurred())
+
+				genDecl, funcDecls := margarine.StructFromMethods("MyInterface", typeSpec.TypeParams, methods)
+
+				err = margarine.FakifyWithOpts(fset, genDecl, &funcDecls, margarine.FakifyOpts{ReceiverName: receiverName})
+				Expect(err).To(MatchError("MyInterface: unsupported " + message))
+
+				var unsupportedErr *margarine.UnsupportedError
+				Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+			},
+			Entry("not an identifier", "my-fake", `receiver name "my-fake", which is not an identifier`),
+			Entry("a keyword", "func", `receiver name "func", which is not an identifier`),
+			Entry("blank", "_", `receiver name "_", which is not an identifier`),
+			Entry("a local", "call", `receiver name "call", which the fake's methods use`),
+			Entry("another local", "results", `receiver name "results", which the fake's methods use`),
+			Entry("a param", "arg1", `receiver name "arg1", which the fake's methods use`),
+			Entry("a copy of a param", "arg2Copy", `receiver name "arg2Copy", which the fake's methods use`),
+			Entry("a package of the fake", "sync", `receiver name "sync", which the fake's methods use`),
+			Entry("a builtin", "len", `receiver name "len", which the fake's methods use`),
+			Entry("a type parameter", "T", `receiver name "T", which is the name of a type parameter`),
+			Entry("a package of the signatures", "io", `receiver name "io", which is the name of a package the methods use`),
+			Entry("the name of a result", "result1", `receiver name "result1", which the fake's methods use`),
+		)
+
+		DescribeTable("returns an UnsupportedError when the signatures use a name the fake's methods use for a local",
+			func(typeParams string, signature string, message string) {
+				src := []byte(`
+package mypackage
+
+import (
+	arg1 "example.com/arg1"
+	result1 "example.com/result1"
+	results "example.com/results"
+)
+
+type MyInterface` + typeParams + ` interface {
+	` + signature + `
+}
+`)
+
+				fset := token.NewFileSet()
+				file, err := parser.ParseFile(fset, "src.go", src, 0)
+				Expect(err).NotTo(HaveOccurred())
+
+				typeSpec := file.Decls[1].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+				methods, _, err := margarine.Flatten(fset, []*ast.File{file}, file, typeSpec)
+				Expect(err).NotTo(HaveOccurred())
+
+				genDecl, funcDecls := margarine.StructFromMethods("MyInterface", typeSpec.TypeParams, methods)
+
+				err = margarine.FakifyWithOpts(fset, genDecl, &funcDecls, margarine.FakifyOpts{})
+				Expect(err).To(MatchError("MyInterface: unsupported " + message))
+
+				var unsupportedErr *margarine.UnsupportedError
+				Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+			},
+			Entry("a package named after a local", "", "Get() results.Thing", `package name "results", which the fake's methods use`),
+			Entry("a package named after a param", "", "Get(arg1.Thing)", `package name "arg1", which the fake's methods use`),
+			Entry("a package named after a result", "", "Get() result1.Thing", `package name "result1", which the fake's methods use`),
+			Entry("a type parameter named after a local", "[stub any]", "Get() stub", `type parameter "stub", which the fake's methods use`),
+		)
+
+		DescribeTable("leaves out the fake's methods that the interface has methods of the same name as",
+			func(methods string, implemented string, generated []string, omitted []string) {
+				src := []byte(`
+package mypackage
+
+type MyInterface interface {
+` + methods + `
+}
+`)
+
+				fset := token.NewFileSet()
+				file, err := parser.ParseFile(fset, "src.go", src, 0)
+				Expect(err).NotTo(HaveOccurred())
+
+				typeSpec := file.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+				flattened, _, err := margarine.Flatten(fset, []*ast.File{file}, file, typeSpec)
+				Expect(err).NotTo(HaveOccurred())
+
+				genDecl, funcDecls := margarine.StructFromMethods("MyInterface", nil, flattened)
+
+				err = margarine.FakifyWithOpts(fset, genDecl, &funcDecls, margarine.FakifyOpts{})
+				Expect(err).NotTo(HaveOccurred())
+
+				// the interface's method is implemented like any other
+				Expect(printFuncDecl(funcDecls, implemented)).To(ContainSubstring("\tstub := fake." + implemented + "Stub\n"))
+
+				for _, name := range generated {
+					Expect(findFuncDecl(funcDecls, name)).NotTo(BeNil(), name)
+				}
+				for _, name := range omitted {
+					Expect(findFuncDecl(funcDecls, name)).To(BeNil(), name)
+				}
+			},
+			Entry("Reset", "Reset()", "Reset",
+				[]string{"ResetStubs", "Invocations", "Calls"}, nil),
+			Entry("ResetStubs", "ResetStubs()", "ResetStubs",
+				[]string{"Reset", "Invocations", "Calls"}, nil),
+			Entry("Invocations", "Invocations() int", "Invocations",
+				[]string{"Reset", "ResetStubs", "Calls", "recordResults"}, nil),
+			Entry("Calls, which takes the place of the log of calls", "Calls() int", "Calls",
+				[]string{"Reset", "ResetStubs", "Invocations"}, []string{"recordResults"}),
+		)
+
+		DescribeTable("returns an UnsupportedError when a name the fake declares clashes with another",
+			func(methods string, opts margarine.FakifyOpts, message string) {
+				src := []byte(`
+package mypackage
+
+type MyInterface interface {
+` + methods + `
+}
+`)
+
+				fset := token.NewFileSet()
+				file, err := parser.ParseFile(fset, "src.go", src, 0)
+				Expect(err).NotTo(HaveOccurred())
+
+				typeSpec := file.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+				flattened, _, err := margarine.Flatten(fset, []*ast.File{file}, file, typeSpec)
+				Expect(err).NotTo(HaveOccurred())
+
+				genDecl, funcDecls := margarine.StructFromMethods("MyInterface", nil, flattened)
+
+				err = margarine.FakifyWithOpts(fset, genDecl, &funcDecls, opts)
+				if message == "" {
+					Expect(err).NotTo(HaveOccurred())
+					return
+				}
+				Expect(err).To(MatchError("MyInterface: unsupported " + message))
+
+				var unsupportedErr *margarine.UnsupportedError
+				Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+			},
+			Entry("XxxCalls", "Foo()\n\tFooCalls()", margarine.FakifyOpts{},
+				"method FooCalls, which clashes with the fake's method FooCalls for Foo"),
+			Entry("XxxCalls without stub setters", "Foo()\n\tFooCalls()", margarine.FakifyOpts{OmitStubSetters: true},
+				""),
+			Entry("XxxReturns", "Foo() int\n\tFooReturns()", margarine.FakifyOpts{},
+				"method FooReturns, which clashes with the fake's method FooReturns for Foo"),
+			Entry("XxxCallCount", "Foo()\n\tFooCallCount() int", margarine.FakifyOpts{},
+				"method FooCallCount, which clashes with the fake's method FooCallCount for Foo"),
+			Entry("XxxArgsForCall", "Foo(int)\n\tFooArgsForCall(int) int", margarine.FakifyOpts{},
+				"method FooArgsForCall, which clashes with the fake's method FooArgsForCall for Foo"),
+			Entry("XxxResultsForCall", "Foo() int\n\tFooResultsForCall(int) int", margarine.FakifyOpts{},
+				"method FooResultsForCall, which clashes with the fake's method FooResultsForCall for Foo"),
+			Entry("XxxStub", "Foo()\n\tFooStub()", margarine.FakifyOpts{},
+				"method FooStub, which clashes with the fake's field FooStub for Foo"),
+			Entry("FailUnstubbed", "Foo() int\n\tFailUnstubbed()", margarine.FakifyOpts{Strict: true},
+				"method FailUnstubbed, which clashes with the fake's field FailUnstubbed"),
+			Entry("FailUnstubbed when not strict", "Foo() int\n\tFailUnstubbed()", margarine.FakifyOpts{},
+				""),
+			Entry("the fields of methods differing only in case", "Foo()\n\tfoo()", margarine.FakifyOpts{},
+				"the fake's field fooMutex for Foo, which clashes with the fake's field fooMutex for foo"),
+		)
+	})
+
+	Describe("Fakify with a generic interface", func() {
+		var (
+			genDecl   *ast.GenDecl
+			funcDecls []*ast.FuncDecl
+		)
+
+		BeforeEach(func() {
+			src := []byte(`
+package mypackage
+
+type Store[K comparable, V any] interface {
+	Get(K) (V, error)
+}
+`)
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "src.go", src, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			typeSpec := file.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+			methods, _, err := margarine.Flatten(fset, []*ast.File{file}, file, typeSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			genDecl, funcDecls = margarine.StructFromMethods("Store", typeSpec.TypeParams, methods)
+		})
+
+		JustBeforeEach(func() {
+			err := margarine.Fakify(token.NewFileSet(), genDecl, &funcDecls)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("gives the fake the type params of the interface", func() {
+			// type FakeStore[K comparable, V any] struct
+			typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
+			Expect(ok).To(BeTrue())
+
+			Expect(typeSpec.Name.Name).To(Equal("FakeStore"))
+			Expect(typeSpec.TypeParams.List).To(HaveLen(2))
+			Expect(typeSpec.TypeParams.List[0].Names[0].Name).To(Equal("K"))
+			Expect(typeSpec.TypeParams.List[1].Names[0].Name).To(Equal("V"))
+		})
+
+		It("instantiates the fake in each method receiver", func() {
+			// func (fake *FakeStore[K, V]) ...
+			Expect(funcDecls).NotTo(BeEmpty())
+
+			for _, funcDecl := range funcDecls {
+				Expect(funcDecl.Recv.List[0].Type).To(Equal(&ast.StarExpr{
+					X: &ast.IndexListExpr{
+						X:       ast.NewIdent("FakeStore"),
+						Indices: []ast.Expr{ast.NewIdent("K"), ast.NewIdent("V")},
+					},
+				}))
+			}
+		})
+	})
+
+	Describe("Fakify with args the caller can change after the call", func() {
+		var (
+			genDecl   *ast.GenDecl
+			funcDecls []*ast.FuncDecl
+			opts      margarine.FakifyOpts
+		)
+
+		var method = func() string {
+			return printFuncDecl(funcDecls, "Method")
+		}
+
+		BeforeEach(func() {
+			src := []byte(`
+package mypackage
+
+type MyInterface interface {
+	Method([]byte, map[string]int, *Thing, [2]int, IP)
+}
+`)
+
+			var err error
+			genDecl, funcDecls, err = patrick.Pour(src, "MyInterface", "MyStruct")
+			Expect(err).NotTo(HaveOccurred())
+
+			opts = margarine.FakifyOpts{}
+		})
+
+		JustBeforeEach(func() {
+			err := margarine.FakifyWithOpts(token.NewFileSet(), genDecl, &funcDecls, opts)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records a copy of slice args", func() {
+			Expect(method()).To(ContainSubstring(`	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+`))
+			Expect(method()).To(ContainSubstring(`}{arg1Copy, arg2, arg3, arg4, arg5})`))
+			Expect(method()).To(ContainSubstring(`fake.recordInvocation("Method", []interface{}{arg1Copy, arg2, arg3, arg4, arg5})`))
+		})
+
+		It("passes the original args on to the stub", func() {
+			Expect(method()).To(ContainSubstring("\tstub(arg1, arg2, arg3, arg4, arg5)\n"))
+		})
+
+		It("does not add the deepCopy method", func() {
+			Expect(findFuncDecl(funcDecls, "deepCopy")).To(BeNil())
+		})
+
+		Context("when DeepCopyArgs is set", func() {
+			BeforeEach(func() {
+				opts.DeepCopyArgs = true
+			})
+
+			It("records deep copies of slice, map and pointer args", func() {
+				Expect(method()).To(ContainSubstring("\targ1Copy := *fake.deepCopy(&arg1).(*[]byte)\n"))
+				Expect(method()).To(ContainSubstring("\targ2Copy := *fake.deepCopy(&arg2).(*map[string]int)\n"))
+				Expect(method()).To(ContainSubstring("\targ3Copy := *fake.deepCopy(&arg3).(**Thing)\n"))
+			})
+
+			It("records deep copies of args of named types, which may be slices", func() {
+				Expect(method()).To(ContainSubstring("\targ5Copy := *fake.deepCopy(&arg5).(*IP)\n"))
+			})
+
+			It("records arrays of values as they are", func() {
+				Expect(method()).NotTo(ContainSubstring("arg4Copy"))
+			})
+
+			It("records the copies", func() {
+				Expect(method()).To(ContainSubstring(`}{arg1Copy, arg2Copy, arg3Copy, arg4, arg5Copy})`))
+				Expect(method()).To(ContainSubstring(`fake.recordInvocation("Method", []interface{}{arg1Copy, arg2Copy, arg3Copy, arg4, arg5Copy})`))
+			})
+
+			It("adds the deepCopy method, which leaves what pointers to locks point to uncopied", func() {
+				Expect(printFuncDecl(funcDecls, "deepCopy")).To(ContainSubstring(`func (fake *FakeMyStruct) deepCopy(arg interface{}) interface{} {
+	copies := map[uintptr]reflect.Value{}
+	var holdsLock func(valueType reflect.Type) bool
+	holdsLock = func(valueType reflect.Type) bool {
+		if reflect.PtrTo(valueType).Implements(reflect.TypeOf((*sync.Locker)(nil)).Elem()) {
+			return true
+		}
+`))
+				Expect(printFuncDecl(funcDecls, "deepCopy")).To(ContainSubstring(`		switch value.Kind() {
+		case reflect.Ptr:
+			if value.IsNil() || holdsLock(value.Type().Elem()) {
+				return value
+			}
+			if seen, found := copies[value.Pointer()]; found && seen.Type() == value.Type() {
+				return seen
+			}
+			copied := reflect.New(value.Type().Elem())
+			copies[value.Pointer()] = copied
+			copied.Elem().Set(copyValue(value.Elem()))
+			return copied
+`))
+				Expect(printFuncDecl(funcDecls, "deepCopy")).To(HaveSuffix("\treturn copyValue(reflect.ValueOf(arg)).Interface()\n}"))
+			})
+
+			Context("when no args hold memory the caller can change", func() {
+				BeforeEach(func() {
+					src := []byte(`
+package mypackage
+
+type MyInterface interface {
+	Method(string, [2]int, error, func())
+}
+`)
+
+					var err error
+					genDecl, funcDecls, err = patrick.Pour(src, "MyInterface", "MyStruct")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("records the args as they are", func() {
+					Expect(method()).To(ContainSubstring(`fake.recordInvocation("Method", []interface{}{arg1, arg2, arg3, arg4})`))
+				})
+
+				It("does not add the deepCopy method", func() {
+					Expect(findFuncDecl(funcDecls, "deepCopy")).To(BeNil())
+				})
+			})
+		})
+	})
+
+	Describe("InterfaceAssertion", func() {
+		It("asserts that the fake implements the interface in another package", func() {
+			// var _ fixtures.Simple = new(FakeSimple)
+			genDecl := margarine.InterfaceAssertion("FakeSimple", "fixtures", "Simple")
+			Expect(genDecl.Tok).To(Equal(token.VAR))
+			Expect(genDecl.Specs).To(HaveLen(1))
+
+			valueSpec, ok := genDecl.Specs[0].(*ast.ValueSpec)
+			Expect(ok).To(BeTrue())
+
+			Expect(valueSpec.Names).To(Equal([]*ast.Ident{ast.NewIdent("_")}))
+			Expect(valueSpec.Type).To(Equal(&ast.SelectorExpr{
+				X:   ast.NewIdent("fixtures"),
+				Sel: ast.NewIdent("Simple"),
+			}))
+			Expect(valueSpec.Values).To(Equal([]ast.Expr{&ast.CallExpr{
+				Fun:  ast.NewIdent("new"),
+				Args: []ast.Expr{ast.NewIdent("FakeSimple")},
+			}}))
+		})
+
+		It("does not qualify an interface in the same package as the fake", func() {
+			// var _ Simple = new(FakeSimple)
+			genDecl := margarine.InterfaceAssertion("FakeSimple", "", "Simple")
+			Expect(genDecl.Specs).To(HaveLen(1))
+
+			valueSpec, ok := genDecl.Specs[0].(*ast.ValueSpec)
+			Expect(ok).To(BeTrue())
+			Expect(valueSpec.Type).To(Equal(ast.NewIdent("Simple")))
 		})
 	})
 
@@ -0,0 +1,208 @@
+package margarine
+
+import (
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FindOpts controls which files FindInterface looks in.
+type FindOpts struct {
+	// IncludeTests looks in _test.go files as well.
+	IncludeTests bool
+
+	// IgnoreBuildConstraints looks in the files of every platform and build
+	// tag, rather than only those the go tool would build for GOOS and
+	// GOARCH. An interface declared in files for different platforms is
+	// then ambiguous.
+	IgnoreBuildConstraints bool
+}
+
+// FoundInterface is an interface found by FindInterface, in the form Flatten
+// and Generate take it.
+type FoundInterface struct {
+	Filename string
+	File     *ast.File
+	TypeSpec *ast.TypeSpec
+
+	// Files are the files of the package that declares the interface.
+	Files []*ast.File
+}
+
+// FindInterface parses the .go files in dir, other than _test.go files unless
+// opts.IncludeTests is set, and returns the declaration of the interface
+// named name. Files excluded by their build constraints, such as
+// iface_windows.go on linux or those tagged //go:build ignore, are skipped
+// unless opts.IgnoreBuildConstraints is set.
+func FindInterface(fset *token.FileSet, dir string, name string, opts FindOpts) (*FoundInterface, error) {
+	files, err := parseDir(fset, dir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return findInterface(fset, dir, files, name)
+}
+
+// FindInterfaces returns the names of the exported interfaces declared in
+// the .go files in dir, which FindInterface looks in with the same opts.
+func FindInterfaces(fset *token.FileSet, dir string, opts FindOpts) ([]string, error) {
+	files, err := parseDir(fset, dir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, name := range interfaceNames(files) {
+		if token.IsExported(name) {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+func parseDir(fset *token.FileSet, dir string, opts FindOpts) ([]*ast.File, error) {
+	filenames, err := goFiles(dir, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, filename := range filenames {
+		file, err := parser.ParseFile(fset, filename, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// goFiles returns the paths of the .go files in dir that the go tool would
+// build, with build constraints applied as build.Default sees them unless
+// opts.IgnoreBuildConstraints is set.
+func goFiles(dir string, opts FindOpts) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var filenames []string
+	for _, entry := range entries {
+		filename := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(filename, ".go") {
+			continue
+		}
+		// ignored by the go tool
+		if strings.HasPrefix(filename, ".") || strings.HasPrefix(filename, "_") {
+			continue
+		}
+		if strings.HasSuffix(filename, "_test.go") && !opts.IncludeTests {
+			continue
+		}
+		if !opts.IgnoreBuildConstraints {
+			match, err := build.Default.MatchFile(dir, filename)
+			if err != nil {
+				return nil, err
+			}
+			if !match {
+				continue
+			}
+		}
+
+		filenames = append(filenames, filepath.Join(dir, filename))
+	}
+
+	return filenames, nil
+}
+
+func findInterface(fset *token.FileSet, dir string, files []*ast.File, name string) (*FoundInterface, error) {
+	var found []*FoundInterface
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if typeSpec.Name.Name == name {
+					found = append(found, &FoundInterface{
+						Filename: fset.Position(file.Package).Filename,
+						File:     file,
+						TypeSpec: typeSpec,
+					})
+				}
+			}
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, &Error{Interface: name, Err: &NotFoundError{Dir: dir, Candidates: interfaceNames(files)}}
+	}
+
+	if len(found) > 1 {
+		var positions []token.Position
+		for _, f := range found {
+			positions = append(positions, fset.Position(f.TypeSpec.Pos()))
+		}
+		return nil, &Error{Interface: name, Err: &AmbiguousError{Candidates: positions}}
+	}
+
+	f := found[0]
+	if _, ok := f.TypeSpec.Type.(*ast.InterfaceType); !ok {
+		return nil, &Error{
+			Pos:       fset.Position(f.TypeSpec.Pos()),
+			Interface: name,
+			Err:       &NotAnInterfaceError{Name: name},
+		}
+	}
+
+	// a directory can hold an external test package alongside the package
+	for _, file := range files {
+		if file.Name.Name == f.File.Name.Name {
+			f.Files = append(f.Files, file)
+		}
+	}
+
+	return f, nil
+}
+
+// interfaceNames returns the sorted names of the interfaces declared in
+// files, each once.
+func interfaceNames(files []*ast.File) []string {
+	var names []string
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if _, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+					names = append(names, typeSpec.Name.Name)
+				}
+			}
+		}
+	}
+
+	sort.Strings(names)
+
+	var unique []string
+	for i, name := range names {
+		if i == 0 || name != names[i-1] {
+			unique = append(unique, name)
+		}
+	}
+
+	return unique
+}
@@ -0,0 +1,169 @@
+package margarine_test
+
+import (
+	"errors"
+	"go/token"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/krishicks/margarine"
+	"github.com/krishicks/margarine/internal/testfiles"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// otherGOOS is a GOOS other than the one the tests run on.
+var otherGOOS = map[bool]string{true: "linux", false: "windows"}[runtime.GOOS == "windows"]
+
+var _ = Describe("FindInterface", func() {
+	var (
+		fset *token.FileSet
+		dir  string
+	)
+
+	BeforeEach(func() {
+		fset = token.NewFileSet()
+
+		dir = testfiles.TempDir()
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Context("when the interface is declared in the package", func() {
+		It("returns the file that declares it and the files of the package", func() {
+			found, err := margarine.FindInterface(fset, "fixtures", "Embedded", margarine.FindOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(found.Filename).To(Equal(filepath.Join("fixtures", "simple.go")))
+			Expect(found.File.Name.Name).To(Equal("fixtures"))
+			Expect(found.TypeSpec.Name.Name).To(Equal("Embedded"))
+			Expect(found.Files).To(HaveLen(3))
+		})
+	})
+
+	Context("when the interface is declared in a _test.go file", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(dir, "iface.go", "package mypackage\n")
+			testfiles.WriteFile(dir, "iface_test.go", "package mypackage\n\ntype MyInterface interface{}\n")
+			testfiles.WriteFile(dir, "external_test.go", "package mypackage_test\n")
+		})
+
+		It("does not find it by default", func() {
+			_, err := margarine.FindInterface(fset, dir, "MyInterface", margarine.FindOpts{})
+
+			var notFoundErr *margarine.NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+		})
+
+		It("finds it when test files are included, among the files of its package", func() {
+			found, err := margarine.FindInterface(fset, dir, "MyInterface", margarine.FindOpts{IncludeTests: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(found.Filename).To(Equal(filepath.Join(dir, "iface_test.go")))
+			Expect(found.Files).To(HaveLen(2))
+		})
+	})
+
+	Context("when the interface is not declared", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(dir, "a.go", "package mypackage\n\ntype B interface{}\n\ntype A interface{}\n\ntype Thing struct{}\n")
+			testfiles.WriteFile(dir, "b_"+runtime.GOOS+".go", "package mypackage\n\ntype C interface{}\n")
+			testfiles.WriteFile(dir, "b_"+otherGOOS+".go", "package mypackage\n\ntype C interface{}\n")
+			testfiles.WriteFile(dir, "c_"+otherGOOS+".go", "package mypackage\n\ntype D interface{}\n")
+		})
+
+		It("returns an error listing the interfaces that are", func() {
+			_, err := margarine.FindInterface(fset, dir, "MyInterface", margarine.FindOpts{})
+			Expect(err).To(MatchError("MyInterface: not found in " + dir + ", which declares A, B, C"))
+
+			var notFoundErr *margarine.NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Candidates).To(Equal([]string{"A", "B", "C"}))
+		})
+	})
+
+	Context("when the interface is declared more than once", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(dir, "a.go", "package mypackage\n\ntype MyInterface interface{}\n")
+			testfiles.WriteFile(dir, "b.go", "package mypackage\n\ntype MyInterface interface{}\n")
+		})
+
+		It("returns an error listing the declarations", func() {
+			_, err := margarine.FindInterface(fset, dir, "MyInterface", margarine.FindOpts{})
+			Expect(err).To(MatchError("MyInterface: declared more than once, at " +
+				filepath.Join(dir, "a.go") + ":3:6, " +
+				filepath.Join(dir, "b.go") + ":3:6"))
+
+			var ambiguousErr *margarine.AmbiguousError
+			Expect(errors.As(err, &ambiguousErr)).To(BeTrue())
+			Expect(ambiguousErr.Candidates).To(HaveLen(2))
+		})
+	})
+
+	Context("when the interface is declared for more than one platform", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(dir, "iface_"+runtime.GOOS+".go", "package mypackage\n\ntype MyInterface interface{}\n")
+			testfiles.WriteFile(dir, "iface_"+otherGOOS+".go", "package mypackage\n\ntype MyInterface interface{}\n")
+			testfiles.WriteFile(dir, "gen.go", "//go:build ignore\n\npackage main\n\ntype MyInterface interface{}\n")
+		})
+
+		It("finds the declaration the go tool would build", func() {
+			found, err := margarine.FindInterface(fset, dir, "MyInterface", margarine.FindOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found.Filename).To(Equal(filepath.Join(dir, "iface_"+runtime.GOOS+".go")))
+			Expect(found.Files).To(HaveLen(1))
+		})
+
+		It("returns an AmbiguousError when build constraints are ignored", func() {
+			_, err := margarine.FindInterface(fset, dir, "MyInterface", margarine.FindOpts{IgnoreBuildConstraints: true})
+
+			var ambiguousErr *margarine.AmbiguousError
+			Expect(errors.As(err, &ambiguousErr)).To(BeTrue())
+			Expect(ambiguousErr.Candidates).To(HaveLen(3))
+		})
+	})
+
+	Context("when the type is not an interface", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(dir, "a.go", "package mypackage\n\ntype MyInterface struct{}\n")
+		})
+
+		It("returns a NotAnInterfaceError", func() {
+			_, err := margarine.FindInterface(fset, dir, "MyInterface", margarine.FindOpts{})
+
+			var notAnInterfaceErr *margarine.NotAnInterfaceError
+			Expect(errors.As(err, &notAnInterfaceErr)).To(BeTrue())
+		})
+	})
+
+	Context("when finding every exported interface", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(dir, "a.go", "package mypackage\n\ntype B interface{}\n\ntype a interface{}\n\ntype Thing struct{}\n")
+			testfiles.WriteFile(dir, "a_test.go", "package mypackage\n\ntype C interface{}\n")
+			testfiles.WriteFile(dir, "b_"+runtime.GOOS+".go", "package mypackage\n\ntype A interface{}\n")
+			testfiles.WriteFile(dir, "b_"+otherGOOS+".go", "package mypackage\n\ntype A interface{}\n")
+			testfiles.WriteFile(dir, "gen.go", "//go:build ignore\n\npackage main\n\ntype D interface{}\n")
+		})
+
+		It("returns their names in order, each once", func() {
+			names, err := margarine.FindInterfaces(fset, dir, margarine.FindOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"A", "B"}))
+		})
+
+		It("includes those in _test.go files when asked to", func() {
+			names, err := margarine.FindInterfaces(fset, dir, margarine.FindOpts{IncludeTests: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"A", "B", "C"}))
+		})
+
+		It("includes those in files excluded by build constraints when asked to", func() {
+			names, err := margarine.FindInterfaces(fset, dir, margarine.FindOpts{IgnoreBuildConstraints: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"A", "B", "D"}))
+		})
+	})
+})
@@ -0,0 +1,423 @@
+// Code generated by margarine. DO NOT EDIT.
+
+package fixturesfakes
+
+import (
+	"github.com/krishicks/margarine/fixtures"
+	"net"
+	"sync"
+	gosync "sync"
+)
+
+type (
+	FakeStore struct {
+		GetStub        func(string) (*fixtures.Record, bool)
+		getMutex       gosync.RWMutex
+		getArgsForCall []struct {
+			arg1 string
+		}
+		getReturns struct {
+			result1 *fixtures.Record
+			result2 bool
+		}
+		getReturnsOnCall map[int]struct {
+			result1 *fixtures.Record
+			result2 bool
+		}
+		getResultsForCall map[int]struct {
+			result1 *fixtures.Record
+			result2 bool
+		}
+		PutStub        func(string, *fixtures.Record, map[string][]string) error
+		putMutex       gosync.RWMutex
+		putArgsForCall []struct {
+			arg1 string
+			arg2 *fixtures.Record
+			arg3 map[string][]string
+		}
+		putReturns struct {
+			result1 error
+		}
+		putReturnsOnCall map[int]struct {
+			result1 error
+		}
+		putResultsForCall map[int]struct {
+			result1 error
+		}
+		AllowStub        func(...net.IP)
+		allowMutex       gosync.RWMutex
+		allowArgsForCall []struct {
+			arg1 []net.IP
+		}
+		LockStub        func(*sync.Mutex)
+		lockMutex       gosync.RWMutex
+		lockArgsForCall []struct {
+			arg1 *sync.Mutex
+		}
+		CloseStub        func()
+		closeMutex       gosync.RWMutex
+		closeArgsForCall []struct {
+		}
+		invocations      map[string][][]interface{}
+		calls            []*FakeStoreCall
+		callSeq          int
+		invocationsMutex gosync.RWMutex
+	}
+	FakeStoreCall struct {
+		Method  string
+		Seq     int
+		Args    []interface{}
+		Results []interface{}
+	}
+)
+
+func (fake *FakeStore) Get(arg1 string) (*fixtures.Record, bool) {
+	fake.getMutex.Lock()
+	stub := fake.GetStub
+	callIndex := len(fake.getArgsForCall)
+	ret, specificReturn := fake.getReturnsOnCall[callIndex]
+	fakeReturns := fake.getReturns
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.getMutex.Unlock()
+	call := fake.recordInvocation("Get", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1, results.result2 = stub(arg1)
+	}
+	fake.getMutex.Lock()
+	if fake.getResultsForCall == nil {
+		fake.getResultsForCall = make(map[int]struct {
+			result1 *fixtures.Record
+			result2 bool
+		})
+	}
+	fake.getResultsForCall[callIndex] = results
+	fake.getMutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1, results.result2})
+	return results.result1, results.result2
+}
+
+func (fake *FakeStore) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeStore) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getArgsForCall[i].arg1
+}
+
+func (fake *FakeStore) GetResultsForCall(i int) (*fixtures.Record, bool) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getResultsForCall[i].result1, fake.getResultsForCall[i].result2
+}
+
+func (fake *FakeStore) GetCalls(stub func(string) (*fixtures.Record, bool)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeStore) GetReturns(result1 *fixtures.Record, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *fixtures.Record
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetReturnsOnCall(i int, result1 *fixtures.Record, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *fixtures.Record
+			result2 bool
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *fixtures.Record
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeStore) Put(arg1 string, arg2 *fixtures.Record, arg3 map[string][]string) error {
+	fake.putMutex.Lock()
+	stub := fake.PutStub
+	callIndex := len(fake.putArgsForCall)
+	ret, specificReturn := fake.putReturnsOnCall[callIndex]
+	fakeReturns := fake.putReturns
+	fake.putArgsForCall = append(fake.putArgsForCall, struct {
+		arg1 string
+		arg2 *fixtures.Record
+		arg3 map[string][]string
+	}{arg1, arg2, arg3})
+	fake.putMutex.Unlock()
+	call := fake.recordInvocation("Put", []interface{}{arg1, arg2, arg3})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1, arg2, arg3)
+	}
+	fake.putMutex.Lock()
+	if fake.putResultsForCall == nil {
+		fake.putResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putResultsForCall[callIndex] = results
+	fake.putMutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeStore) PutCallCount() int {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	return len(fake.putArgsForCall)
+}
+
+func (fake *FakeStore) PutArgsForCall(i int) (string, *fixtures.Record, map[string][]string) {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	return fake.putArgsForCall[i].arg1, fake.putArgsForCall[i].arg2, fake.putArgsForCall[i].arg3
+}
+
+func (fake *FakeStore) PutResultsForCall(i int) error {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	return fake.putResultsForCall[i].result1
+}
+
+func (fake *FakeStore) PutCalls(stub func(string, *fixtures.Record, map[string][]string) error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = stub
+}
+
+func (fake *FakeStore) PutReturns(result1 error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = nil
+	fake.putReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutReturnsOnCall(i int, result1 error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = nil
+	if fake.putReturnsOnCall == nil {
+		fake.putReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) Allow(arg1 ...net.IP) {
+	var arg1Copy []net.IP
+	if arg1 != nil {
+		arg1Copy = make([]net.IP, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.allowMutex.Lock()
+	stub := fake.AllowStub
+	fake.allowArgsForCall = append(fake.allowArgsForCall, struct {
+		arg1 []net.IP
+	}{arg1Copy})
+	fake.allowMutex.Unlock()
+	fake.recordInvocation("Allow", []interface{}{arg1Copy})
+	if stub != nil {
+		stub(arg1...)
+	}
+}
+
+func (fake *FakeStore) AllowCallCount() int {
+	fake.allowMutex.RLock()
+	defer fake.allowMutex.RUnlock()
+	return len(fake.allowArgsForCall)
+}
+
+func (fake *FakeStore) AllowArgsForCall(i int) []net.IP {
+	fake.allowMutex.RLock()
+	defer fake.allowMutex.RUnlock()
+	return fake.allowArgsForCall[i].arg1
+}
+
+func (fake *FakeStore) AllowCalls(stub func(...net.IP)) {
+	fake.allowMutex.Lock()
+	defer fake.allowMutex.Unlock()
+	fake.AllowStub = stub
+}
+
+func (fake *FakeStore) Lock(arg1 *sync.Mutex) {
+	fake.lockMutex.Lock()
+	stub := fake.LockStub
+	fake.lockArgsForCall = append(fake.lockArgsForCall, struct {
+		arg1 *sync.Mutex
+	}{arg1})
+	fake.lockMutex.Unlock()
+	fake.recordInvocation("Lock", []interface{}{arg1})
+	if stub != nil {
+		stub(arg1)
+	}
+}
+
+func (fake *FakeStore) LockCallCount() int {
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
+	return len(fake.lockArgsForCall)
+}
+
+func (fake *FakeStore) LockArgsForCall(i int) *sync.Mutex {
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
+	return fake.lockArgsForCall[i].arg1
+}
+
+func (fake *FakeStore) LockCalls(stub func(*sync.Mutex)) {
+	fake.lockMutex.Lock()
+	defer fake.lockMutex.Unlock()
+	fake.LockStub = stub
+}
+
+func (fake *FakeStore) Close() {
+	fake.closeMutex.Lock()
+	stub := fake.CloseStub
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	fake.closeMutex.Unlock()
+	fake.recordInvocation("Close", []interface{}{})
+	if stub != nil {
+		stub()
+	}
+}
+
+func (fake *FakeStore) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *FakeStore) CloseCalls(stub func()) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *FakeStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = make([][]interface{}, len(value))
+		for i, args := range value {
+			copiedInvocations[key][i] = append([]interface{}{}, args...)
+		}
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStore) Calls() []FakeStoreCall {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedCalls := make([]FakeStoreCall, len(fake.calls))
+	for i, call := range fake.calls {
+		copiedCalls[i] = *call
+		copiedCalls[i].Args = append([]interface{}{}, call.Args...)
+		copiedCalls[i].Results = append([]interface{}{}, call.Results...)
+	}
+	return copiedCalls
+}
+
+func (fake *FakeStore) Reset() {
+	fake.getMutex.Lock()
+	fake.getArgsForCall = nil
+	fake.getResultsForCall = nil
+	fake.getMutex.Unlock()
+	fake.putMutex.Lock()
+	fake.putArgsForCall = nil
+	fake.putResultsForCall = nil
+	fake.putMutex.Unlock()
+	fake.allowMutex.Lock()
+	fake.allowArgsForCall = nil
+	fake.allowMutex.Unlock()
+	fake.lockMutex.Lock()
+	fake.lockArgsForCall = nil
+	fake.lockMutex.Unlock()
+	fake.closeMutex.Lock()
+	fake.closeArgsForCall = nil
+	fake.closeMutex.Unlock()
+	fake.invocationsMutex.Lock()
+	fake.invocations = nil
+	fake.calls = nil
+	fake.invocationsMutex.Unlock()
+}
+
+func (fake *FakeStore) ResetStubs() {
+	fake.getMutex.Lock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *fixtures.Record
+		result2 bool
+	}{}
+	fake.getReturnsOnCall = nil
+	fake.getMutex.Unlock()
+	fake.putMutex.Lock()
+	fake.PutStub = nil
+	fake.putReturns = struct {
+		result1 error
+	}{}
+	fake.putReturnsOnCall = nil
+	fake.putMutex.Unlock()
+	fake.allowMutex.Lock()
+	fake.AllowStub = nil
+	fake.allowMutex.Unlock()
+	fake.lockMutex.Lock()
+	fake.LockStub = nil
+	fake.lockMutex.Unlock()
+	fake.closeMutex.Lock()
+	fake.CloseStub = nil
+	fake.closeMutex.Unlock()
+}
+
+func (fake *FakeStore) recordInvocation(key string, args []interface{}) *FakeStoreCall {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	fake.callSeq++
+	call := &FakeStoreCall{Method: key, Seq: fake.callSeq, Args: args}
+	fake.calls = append(fake.calls, call)
+	return call
+}
+
+func (fake *FakeStore) recordResults(call *FakeStoreCall, results []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	call.Results = results
+}
+
+var _ fixtures.Store = new(FakeStore)
@@ -0,0 +1,239 @@
+package fixturesfakes_test
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/krishicks/margarine/fixtures"
+	"github.com/krishicks/margarine/fixtures/fixturesfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FakeStore", func() {
+	var fake *fixturesfakes.FakeStore
+
+	BeforeEach(func() {
+		fake = new(fixturesfakes.FakeStore)
+	})
+
+	It("returns zero values from calls without a stub or return values", func() {
+		record, ok := fake.Get("key")
+		Expect(record).To(BeNil())
+		Expect(ok).To(BeFalse())
+
+		fake.Close()
+		Expect(fake.CloseCallCount()).To(Equal(1))
+	})
+
+	It("records a copy of variadic args, but not of what they hold", func() {
+		ips := []net.IP{{10, 0, 0, 1}}
+		fake.Allow(ips...)
+
+		ips[0][3] = 2
+		ips[0] = net.IP{10, 0, 0, 3}
+		Expect(fake.AllowArgsForCall(0)).To(Equal([]net.IP{{10, 0, 0, 2}}))
+	})
+
+	It("records map and pointer args as they are", func() {
+		record := &fixtures.Record{Name: "before"}
+		tags := map[string][]string{"env": {"test"}}
+		fake.Put("key", record, tags)
+
+		record.Name = "after"
+		tags["env"] = nil
+
+		_, recordedRecord, recordedTags := fake.PutArgsForCall(0)
+		Expect(recordedRecord).To(BeIdenticalTo(record))
+		Expect(recordedTags).To(HaveKeyWithValue("env", BeNil()))
+	})
+
+	Describe("Invocations", func() {
+		It("returns a snapshot that later calls don't change", func() {
+			fake.Get("first")
+			invocations := fake.Invocations()
+
+			fake.Get("second")
+			Expect(invocations).To(Equal(map[string][][]interface{}{
+				"Get": {{"first"}},
+			}))
+		})
+
+		It("returns a snapshot that changing doesn't change the fake's", func() {
+			fake.Get("first")
+
+			invocations := fake.Invocations()
+			invocations["Get"][0][0] = "changed"
+			delete(invocations, "Get")
+
+			Expect(fake.Invocations()).To(Equal(map[string][][]interface{}{
+				"Get": {{"first"}},
+			}))
+		})
+
+		It("can be called while other goroutines call the fake", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					fake.Get(fmt.Sprint(i))
+					for _, args := range fake.Invocations()["Get"] {
+						Expect(args).To(HaveLen(1))
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			Expect(fake.Invocations()["Get"]).To(HaveLen(10))
+		})
+	})
+})
+
+var _ = Describe("StrictStore", func() {
+	var (
+		fake     *fixturesfakes.StrictStore
+		failures []string
+	)
+
+	BeforeEach(func() {
+		failures = nil
+
+		fake = new(fixturesfakes.StrictStore)
+		fake.FailUnstubbed = func(format string, args ...interface{}) {
+			failures = append(failures, fmt.Sprintf(format, args...))
+		}
+	})
+
+	Describe("a call without a stub", func() {
+		It("fails for a method with results and no return values", func() {
+			fake.Get("key")
+			Expect(failures).To(Equal([]string{"Get called with [key], but it has no stub or return values"}))
+		})
+
+		It("fails for a method without results", func() {
+			fake.Close()
+			Expect(failures).To(Equal([]string{"Close called with [], but it has no stub or return values"}))
+		})
+
+		It("is still recorded", func() {
+			fake.Close()
+			Expect(fake.CloseCallCount()).To(Equal(1))
+		})
+
+		It("panics when FailUnstubbed is not set", func() {
+			fake.FailUnstubbed = nil
+			Expect(fake.Close).To(PanicWith("Close called with [], but it has no stub or return values"))
+		})
+
+		It("does not fail for a method with return values", func() {
+			fake.GetReturns(nil, true)
+			fake.PutReturnsOnCall(0, nil)
+
+			_, ok := fake.Get("key")
+			Expect(ok).To(BeTrue())
+			fake.Put("key", nil, nil)
+
+			Expect(failures).To(BeEmpty())
+		})
+
+		It("fails again after ResetStubs", func() {
+			fake.GetReturns(nil, true)
+			fake.ResetStubs()
+
+			fake.Get("key")
+			Expect(failures).To(HaveLen(1))
+		})
+	})
+
+	It("does not fail a call with a stub", func() {
+		fake.CloseCalls(func() {})
+		fake.GetCalls(func(string) (*fixtures.Record, bool) { return nil, true })
+
+		fake.Close()
+		fake.Get("key")
+
+		Expect(failures).To(BeEmpty())
+	})
+
+	Describe("the recorded args", func() {
+		BeforeEach(func() {
+			fake.PutReturns(nil)
+			fake.AllowCalls(func(...net.IP) {})
+			fake.LockCalls(func(*sync.Mutex) {})
+		})
+
+		It("are deep copies of map and pointer args", func() {
+			record := &fixtures.Record{
+				Name:   "before",
+				Labels: map[string]string{"team": "a"},
+				Parent: &fixtures.Record{Name: "parent"},
+			}
+			tags := map[string][]string{"env": {"test"}}
+			fake.Put("key", record, tags)
+
+			record.Name = "after"
+			record.Labels["team"] = "b"
+			record.Parent.Name = "other"
+			tags["env"][0] = "prod"
+
+			_, recordedRecord, recordedTags := fake.PutArgsForCall(0)
+			Expect(recordedRecord).To(Equal(&fixtures.Record{
+				Name:   "before",
+				Labels: map[string]string{"team": "a"},
+				Parent: &fixtures.Record{Name: "parent"},
+			}))
+			Expect(recordedTags).To(Equal(map[string][]string{"env": {"test"}}))
+			Expect(fake.Invocations()["Put"][0][1]).To(BeIdenticalTo(recordedRecord))
+		})
+
+		It("are deep copies of named slice types", func() {
+			ip := net.IP{10, 0, 0, 1}
+			fake.Allow(ip)
+
+			ip[3] = 2
+			Expect(fake.AllowArgsForCall(0)).To(Equal([]net.IP{{10, 0, 0, 1}}))
+		})
+
+		It("keep the cycles of the args", func() {
+			record := &fixtures.Record{Name: "loop"}
+			record.Parent = record
+			fake.Put("key", record, nil)
+
+			_, recordedRecord, _ := fake.PutArgsForCall(0)
+			Expect(recordedRecord).NotTo(BeIdenticalTo(record))
+			Expect(recordedRecord.Parent).To(BeIdenticalTo(recordedRecord))
+		})
+
+		It("are nil for nil args", func() {
+			fake.Put("key", nil, nil)
+
+			_, recordedRecord, recordedTags := fake.PutArgsForCall(0)
+			Expect(recordedRecord).To(BeNil())
+			Expect(recordedTags).To(BeNil())
+		})
+
+		It("are the pointers themselves for pointers to locks", func() {
+			var mu sync.Mutex
+			fake.Lock(&mu)
+
+			Expect(fake.LockArgsForCall(0)).To(BeIdenticalTo(&mu))
+		})
+
+		It("are not what the stub is passed", func() {
+			record := &fixtures.Record{Name: "record"}
+
+			var stubbed *fixtures.Record
+			fake.PutCalls(func(_ string, record *fixtures.Record, _ map[string][]string) error {
+				stubbed = record
+				return nil
+			})
+			fake.Put("key", record, nil)
+
+			Expect(stubbed).To(BeIdenticalTo(record))
+			_, recordedRecord, _ := fake.PutArgsForCall(0)
+			Expect(recordedRecord).NotTo(BeIdenticalTo(record))
+		})
+	})
+})
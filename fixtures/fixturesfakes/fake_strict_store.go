@@ -0,0 +1,527 @@
+// Code generated by margarine. DO NOT EDIT.
+
+package fixturesfakes
+
+import (
+	"fmt"
+	"github.com/krishicks/margarine/fixtures"
+	"net"
+	"reflect"
+	"sync"
+	gosync "sync"
+)
+
+type (
+	StrictStore struct {
+		GetStub        func(string) (*fixtures.Record, bool)
+		getMutex       gosync.RWMutex
+		getArgsForCall []struct {
+			arg1 string
+		}
+		getReturns struct {
+			result1 *fixtures.Record
+			result2 bool
+		}
+		getReturnsSet    bool
+		getReturnsOnCall map[int]struct {
+			result1 *fixtures.Record
+			result2 bool
+		}
+		getResultsForCall map[int]struct {
+			result1 *fixtures.Record
+			result2 bool
+		}
+		PutStub        func(string, *fixtures.Record, map[string][]string) error
+		putMutex       gosync.RWMutex
+		putArgsForCall []struct {
+			arg1 string
+			arg2 *fixtures.Record
+			arg3 map[string][]string
+		}
+		putReturns struct {
+			result1 error
+		}
+		putReturnsSet    bool
+		putReturnsOnCall map[int]struct {
+			result1 error
+		}
+		putResultsForCall map[int]struct {
+			result1 error
+		}
+		AllowStub        func(...net.IP)
+		allowMutex       gosync.RWMutex
+		allowArgsForCall []struct {
+			arg1 []net.IP
+		}
+		LockStub        func(*sync.Mutex)
+		lockMutex       gosync.RWMutex
+		lockArgsForCall []struct {
+			arg1 *sync.Mutex
+		}
+		CloseStub        func()
+		closeMutex       gosync.RWMutex
+		closeArgsForCall []struct {
+		}
+		FailUnstubbed    func(format string, args ...interface{})
+		invocations      map[string][][]interface{}
+		calls            []*StrictStoreCall
+		callSeq          int
+		invocationsMutex gosync.RWMutex
+	}
+	StrictStoreCall struct {
+		Method  string
+		Seq     int
+		Args    []interface{}
+		Results []interface{}
+	}
+)
+
+func (fake *StrictStore) Get(arg1 string) (*fixtures.Record, bool) {
+	fake.getMutex.Lock()
+	stub := fake.GetStub
+	callIndex := len(fake.getArgsForCall)
+	ret, specificReturn := fake.getReturnsOnCall[callIndex]
+	fakeReturns := fake.getReturns
+	returnsSet := fake.getReturnsSet
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.getMutex.Unlock()
+	call := fake.recordInvocation("Get", []interface{}{arg1})
+	if stub == nil && !specificReturn && !returnsSet {
+		fake.failUnstubbed("Get", []interface{}{arg1})
+	}
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1, results.result2 = stub(arg1)
+	}
+	fake.getMutex.Lock()
+	if fake.getResultsForCall == nil {
+		fake.getResultsForCall = make(map[int]struct {
+			result1 *fixtures.Record
+			result2 bool
+		})
+	}
+	fake.getResultsForCall[callIndex] = results
+	fake.getMutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1, results.result2})
+	return results.result1, results.result2
+}
+
+func (fake *StrictStore) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *StrictStore) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getArgsForCall[i].arg1
+}
+
+func (fake *StrictStore) GetResultsForCall(i int) (*fixtures.Record, bool) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getResultsForCall[i].result1, fake.getResultsForCall[i].result2
+}
+
+func (fake *StrictStore) GetCalls(stub func(string) (*fixtures.Record, bool)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *StrictStore) GetReturns(result1 *fixtures.Record, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *fixtures.Record
+		result2 bool
+	}{result1, result2}
+	fake.getReturnsSet = true
+}
+
+func (fake *StrictStore) GetReturnsOnCall(i int, result1 *fixtures.Record, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *fixtures.Record
+			result2 bool
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *fixtures.Record
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *StrictStore) Put(arg1 string, arg2 *fixtures.Record, arg3 map[string][]string) error {
+	arg2Copy := *fake.deepCopy(&arg2).(**fixtures.Record)
+	arg3Copy := *fake.deepCopy(&arg3).(*map[string][]string)
+	fake.putMutex.Lock()
+	stub := fake.PutStub
+	callIndex := len(fake.putArgsForCall)
+	ret, specificReturn := fake.putReturnsOnCall[callIndex]
+	fakeReturns := fake.putReturns
+	returnsSet := fake.putReturnsSet
+	fake.putArgsForCall = append(fake.putArgsForCall, struct {
+		arg1 string
+		arg2 *fixtures.Record
+		arg3 map[string][]string
+	}{arg1, arg2Copy, arg3Copy})
+	fake.putMutex.Unlock()
+	call := fake.recordInvocation("Put", []interface{}{arg1, arg2Copy, arg3Copy})
+	if stub == nil && !specificReturn && !returnsSet {
+		fake.failUnstubbed("Put", []interface{}{arg1, arg2Copy, arg3Copy})
+	}
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1, arg2, arg3)
+	}
+	fake.putMutex.Lock()
+	if fake.putResultsForCall == nil {
+		fake.putResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putResultsForCall[callIndex] = results
+	fake.putMutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *StrictStore) PutCallCount() int {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	return len(fake.putArgsForCall)
+}
+
+func (fake *StrictStore) PutArgsForCall(i int) (string, *fixtures.Record, map[string][]string) {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	return fake.putArgsForCall[i].arg1, fake.putArgsForCall[i].arg2, fake.putArgsForCall[i].arg3
+}
+
+func (fake *StrictStore) PutResultsForCall(i int) error {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	return fake.putResultsForCall[i].result1
+}
+
+func (fake *StrictStore) PutCalls(stub func(string, *fixtures.Record, map[string][]string) error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = stub
+}
+
+func (fake *StrictStore) PutReturns(result1 error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = nil
+	fake.putReturns = struct {
+		result1 error
+	}{result1}
+	fake.putReturnsSet = true
+}
+
+func (fake *StrictStore) PutReturnsOnCall(i int, result1 error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = nil
+	if fake.putReturnsOnCall == nil {
+		fake.putReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *StrictStore) Allow(arg1 ...net.IP) {
+	arg1Copy := *fake.deepCopy(&arg1).(*[]net.IP)
+	fake.allowMutex.Lock()
+	stub := fake.AllowStub
+	fake.allowArgsForCall = append(fake.allowArgsForCall, struct {
+		arg1 []net.IP
+	}{arg1Copy})
+	fake.allowMutex.Unlock()
+	fake.recordInvocation("Allow", []interface{}{arg1Copy})
+	if stub != nil {
+		stub(arg1...)
+	} else {
+		fake.failUnstubbed("Allow", []interface{}{arg1Copy})
+	}
+}
+
+func (fake *StrictStore) AllowCallCount() int {
+	fake.allowMutex.RLock()
+	defer fake.allowMutex.RUnlock()
+	return len(fake.allowArgsForCall)
+}
+
+func (fake *StrictStore) AllowArgsForCall(i int) []net.IP {
+	fake.allowMutex.RLock()
+	defer fake.allowMutex.RUnlock()
+	return fake.allowArgsForCall[i].arg1
+}
+
+func (fake *StrictStore) AllowCalls(stub func(...net.IP)) {
+	fake.allowMutex.Lock()
+	defer fake.allowMutex.Unlock()
+	fake.AllowStub = stub
+}
+
+func (fake *StrictStore) Lock(arg1 *sync.Mutex) {
+	arg1Copy := *fake.deepCopy(&arg1).(**sync.Mutex)
+	fake.lockMutex.Lock()
+	stub := fake.LockStub
+	fake.lockArgsForCall = append(fake.lockArgsForCall, struct {
+		arg1 *sync.Mutex
+	}{arg1Copy})
+	fake.lockMutex.Unlock()
+	fake.recordInvocation("Lock", []interface{}{arg1Copy})
+	if stub != nil {
+		stub(arg1)
+	} else {
+		fake.failUnstubbed("Lock", []interface{}{arg1Copy})
+	}
+}
+
+func (fake *StrictStore) LockCallCount() int {
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
+	return len(fake.lockArgsForCall)
+}
+
+func (fake *StrictStore) LockArgsForCall(i int) *sync.Mutex {
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
+	return fake.lockArgsForCall[i].arg1
+}
+
+func (fake *StrictStore) LockCalls(stub func(*sync.Mutex)) {
+	fake.lockMutex.Lock()
+	defer fake.lockMutex.Unlock()
+	fake.LockStub = stub
+}
+
+func (fake *StrictStore) Close() {
+	fake.closeMutex.Lock()
+	stub := fake.CloseStub
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	fake.closeMutex.Unlock()
+	fake.recordInvocation("Close", []interface{}{})
+	if stub != nil {
+		stub()
+	} else {
+		fake.failUnstubbed("Close", []interface{}{})
+	}
+}
+
+func (fake *StrictStore) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *StrictStore) CloseCalls(stub func()) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *StrictStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = make([][]interface{}, len(value))
+		for i, args := range value {
+			copiedInvocations[key][i] = append([]interface{}{}, args...)
+		}
+	}
+	return copiedInvocations
+}
+
+func (fake *StrictStore) Calls() []StrictStoreCall {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedCalls := make([]StrictStoreCall, len(fake.calls))
+	for i, call := range fake.calls {
+		copiedCalls[i] = *call
+		copiedCalls[i].Args = append([]interface{}{}, call.Args...)
+		copiedCalls[i].Results = append([]interface{}{}, call.Results...)
+	}
+	return copiedCalls
+}
+
+func (fake *StrictStore) Reset() {
+	fake.getMutex.Lock()
+	fake.getArgsForCall = nil
+	fake.getResultsForCall = nil
+	fake.getMutex.Unlock()
+	fake.putMutex.Lock()
+	fake.putArgsForCall = nil
+	fake.putResultsForCall = nil
+	fake.putMutex.Unlock()
+	fake.allowMutex.Lock()
+	fake.allowArgsForCall = nil
+	fake.allowMutex.Unlock()
+	fake.lockMutex.Lock()
+	fake.lockArgsForCall = nil
+	fake.lockMutex.Unlock()
+	fake.closeMutex.Lock()
+	fake.closeArgsForCall = nil
+	fake.closeMutex.Unlock()
+	fake.invocationsMutex.Lock()
+	fake.invocations = nil
+	fake.calls = nil
+	fake.invocationsMutex.Unlock()
+}
+
+func (fake *StrictStore) ResetStubs() {
+	fake.getMutex.Lock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *fixtures.Record
+		result2 bool
+	}{}
+	fake.getReturnsOnCall = nil
+	fake.getReturnsSet = false
+	fake.getMutex.Unlock()
+	fake.putMutex.Lock()
+	fake.PutStub = nil
+	fake.putReturns = struct {
+		result1 error
+	}{}
+	fake.putReturnsOnCall = nil
+	fake.putReturnsSet = false
+	fake.putMutex.Unlock()
+	fake.allowMutex.Lock()
+	fake.AllowStub = nil
+	fake.allowMutex.Unlock()
+	fake.lockMutex.Lock()
+	fake.LockStub = nil
+	fake.lockMutex.Unlock()
+	fake.closeMutex.Lock()
+	fake.CloseStub = nil
+	fake.closeMutex.Unlock()
+}
+
+func (fake *StrictStore) recordInvocation(key string, args []interface{}) *StrictStoreCall {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	fake.callSeq++
+	call := &StrictStoreCall{Method: key, Seq: fake.callSeq, Args: args}
+	fake.calls = append(fake.calls, call)
+	return call
+}
+
+func (fake *StrictStore) recordResults(call *StrictStoreCall, results []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	call.Results = results
+}
+
+func (fake *StrictStore) deepCopy(arg interface{}) interface{} {
+	copies := map[uintptr]reflect.Value{}
+	var holdsLock func(valueType reflect.Type) bool
+	holdsLock = func(valueType reflect.Type) bool {
+		if reflect.PtrTo(valueType).Implements(reflect.TypeOf((*gosync.Locker)(nil)).Elem()) {
+			return true
+		}
+		switch valueType.Kind() {
+		case reflect.Array:
+			return holdsLock(valueType.Elem())
+		case reflect.Struct:
+			for i := 0; i < valueType.NumField(); i++ {
+				if holdsLock(valueType.Field(i).Type) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	var copyValue func(value reflect.Value) reflect.Value
+	copyValue = func(value reflect.Value) reflect.Value {
+		switch value.Kind() {
+		case reflect.Ptr:
+			if value.IsNil() || holdsLock(value.Type().Elem()) {
+				return value
+			}
+			if seen, found := copies[value.Pointer()]; found && seen.Type() == value.Type() {
+				return seen
+			}
+			copied := reflect.New(value.Type().Elem())
+			copies[value.Pointer()] = copied
+			copied.Elem().Set(copyValue(value.Elem()))
+			return copied
+		case reflect.Map:
+			if value.IsNil() {
+				return value
+			}
+			copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+			iter := value.MapRange()
+			for iter.Next() {
+				copied.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+			}
+			return copied
+		case reflect.Slice:
+			if value.IsNil() {
+				return value
+			}
+			copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+			for i := 0; i < value.Len(); i++ {
+				copied.Index(i).Set(copyValue(value.Index(i)))
+			}
+			return copied
+		case reflect.Array:
+			copied := reflect.New(value.Type()).Elem()
+			for i := 0; i < value.Len(); i++ {
+				copied.Index(i).Set(copyValue(value.Index(i)))
+			}
+			return copied
+		case reflect.Struct:
+			copied := reflect.New(value.Type()).Elem()
+			copied.Set(value)
+			for i := 0; i < value.NumField(); i++ {
+				if copied.Field(i).CanSet() {
+					copied.Field(i).Set(copyValue(value.Field(i)))
+				}
+			}
+			return copied
+		}
+		return value
+	}
+	return copyValue(reflect.ValueOf(arg)).Interface()
+}
+
+func (fake *StrictStore) failUnstubbed(method string, args []interface{}) {
+	message := fmt.Sprintf("%s called with %v, but it has no stub or return values", method, args)
+	if fake.FailUnstubbed != nil {
+		fake.FailUnstubbed("%s", message)
+		return
+	}
+	panic(message)
+}
+
+var _ fixtures.Store = new(StrictStore)
@@ -0,0 +1,10282 @@
+// Code generated by margarine. DO NOT EDIT.
+
+package fixturesfakes
+
+import (
+	"github.com/krishicks/margarine/fixtures"
+	"sync"
+)
+
+type (
+	FakeWide struct {
+		Method0Stub        func(int) error
+		method0Mutex       sync.RWMutex
+		method0ArgsForCall []struct {
+			arg1 int
+		}
+		method0Returns struct {
+			result1 error
+		}
+		method0ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method0ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method1Stub        func(int) error
+		method1Mutex       sync.RWMutex
+		method1ArgsForCall []struct {
+			arg1 int
+		}
+		method1Returns struct {
+			result1 error
+		}
+		method1ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method1ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method2Stub        func(int) error
+		method2Mutex       sync.RWMutex
+		method2ArgsForCall []struct {
+			arg1 int
+		}
+		method2Returns struct {
+			result1 error
+		}
+		method2ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method2ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method3Stub        func(int) error
+		method3Mutex       sync.RWMutex
+		method3ArgsForCall []struct {
+			arg1 int
+		}
+		method3Returns struct {
+			result1 error
+		}
+		method3ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method3ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method4Stub        func(int) error
+		method4Mutex       sync.RWMutex
+		method4ArgsForCall []struct {
+			arg1 int
+		}
+		method4Returns struct {
+			result1 error
+		}
+		method4ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method4ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method5Stub        func(int) error
+		method5Mutex       sync.RWMutex
+		method5ArgsForCall []struct {
+			arg1 int
+		}
+		method5Returns struct {
+			result1 error
+		}
+		method5ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method5ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method6Stub        func(int) error
+		method6Mutex       sync.RWMutex
+		method6ArgsForCall []struct {
+			arg1 int
+		}
+		method6Returns struct {
+			result1 error
+		}
+		method6ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method6ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method7Stub        func(int) error
+		method7Mutex       sync.RWMutex
+		method7ArgsForCall []struct {
+			arg1 int
+		}
+		method7Returns struct {
+			result1 error
+		}
+		method7ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method7ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method8Stub        func(int) error
+		method8Mutex       sync.RWMutex
+		method8ArgsForCall []struct {
+			arg1 int
+		}
+		method8Returns struct {
+			result1 error
+		}
+		method8ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method8ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method9Stub        func(int) error
+		method9Mutex       sync.RWMutex
+		method9ArgsForCall []struct {
+			arg1 int
+		}
+		method9Returns struct {
+			result1 error
+		}
+		method9ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method9ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method10Stub        func(int) error
+		method10Mutex       sync.RWMutex
+		method10ArgsForCall []struct {
+			arg1 int
+		}
+		method10Returns struct {
+			result1 error
+		}
+		method10ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method10ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method11Stub        func(int) error
+		method11Mutex       sync.RWMutex
+		method11ArgsForCall []struct {
+			arg1 int
+		}
+		method11Returns struct {
+			result1 error
+		}
+		method11ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method11ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method12Stub        func(int) error
+		method12Mutex       sync.RWMutex
+		method12ArgsForCall []struct {
+			arg1 int
+		}
+		method12Returns struct {
+			result1 error
+		}
+		method12ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method12ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method13Stub        func(int) error
+		method13Mutex       sync.RWMutex
+		method13ArgsForCall []struct {
+			arg1 int
+		}
+		method13Returns struct {
+			result1 error
+		}
+		method13ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method13ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method14Stub        func(int) error
+		method14Mutex       sync.RWMutex
+		method14ArgsForCall []struct {
+			arg1 int
+		}
+		method14Returns struct {
+			result1 error
+		}
+		method14ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method14ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method15Stub        func(int) error
+		method15Mutex       sync.RWMutex
+		method15ArgsForCall []struct {
+			arg1 int
+		}
+		method15Returns struct {
+			result1 error
+		}
+		method15ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method15ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method16Stub        func(int) error
+		method16Mutex       sync.RWMutex
+		method16ArgsForCall []struct {
+			arg1 int
+		}
+		method16Returns struct {
+			result1 error
+		}
+		method16ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method16ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method17Stub        func(int) error
+		method17Mutex       sync.RWMutex
+		method17ArgsForCall []struct {
+			arg1 int
+		}
+		method17Returns struct {
+			result1 error
+		}
+		method17ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method17ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method18Stub        func(int) error
+		method18Mutex       sync.RWMutex
+		method18ArgsForCall []struct {
+			arg1 int
+		}
+		method18Returns struct {
+			result1 error
+		}
+		method18ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method18ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method19Stub        func(int) error
+		method19Mutex       sync.RWMutex
+		method19ArgsForCall []struct {
+			arg1 int
+		}
+		method19Returns struct {
+			result1 error
+		}
+		method19ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method19ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method20Stub        func(int) error
+		method20Mutex       sync.RWMutex
+		method20ArgsForCall []struct {
+			arg1 int
+		}
+		method20Returns struct {
+			result1 error
+		}
+		method20ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method20ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method21Stub        func(int) error
+		method21Mutex       sync.RWMutex
+		method21ArgsForCall []struct {
+			arg1 int
+		}
+		method21Returns struct {
+			result1 error
+		}
+		method21ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method21ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method22Stub        func(int) error
+		method22Mutex       sync.RWMutex
+		method22ArgsForCall []struct {
+			arg1 int
+		}
+		method22Returns struct {
+			result1 error
+		}
+		method22ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method22ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method23Stub        func(int) error
+		method23Mutex       sync.RWMutex
+		method23ArgsForCall []struct {
+			arg1 int
+		}
+		method23Returns struct {
+			result1 error
+		}
+		method23ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method23ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method24Stub        func(int) error
+		method24Mutex       sync.RWMutex
+		method24ArgsForCall []struct {
+			arg1 int
+		}
+		method24Returns struct {
+			result1 error
+		}
+		method24ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method24ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method25Stub        func(int) error
+		method25Mutex       sync.RWMutex
+		method25ArgsForCall []struct {
+			arg1 int
+		}
+		method25Returns struct {
+			result1 error
+		}
+		method25ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method25ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method26Stub        func(int) error
+		method26Mutex       sync.RWMutex
+		method26ArgsForCall []struct {
+			arg1 int
+		}
+		method26Returns struct {
+			result1 error
+		}
+		method26ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method26ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method27Stub        func(int) error
+		method27Mutex       sync.RWMutex
+		method27ArgsForCall []struct {
+			arg1 int
+		}
+		method27Returns struct {
+			result1 error
+		}
+		method27ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method27ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method28Stub        func(int) error
+		method28Mutex       sync.RWMutex
+		method28ArgsForCall []struct {
+			arg1 int
+		}
+		method28Returns struct {
+			result1 error
+		}
+		method28ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method28ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method29Stub        func(int) error
+		method29Mutex       sync.RWMutex
+		method29ArgsForCall []struct {
+			arg1 int
+		}
+		method29Returns struct {
+			result1 error
+		}
+		method29ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method29ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method30Stub        func(int) error
+		method30Mutex       sync.RWMutex
+		method30ArgsForCall []struct {
+			arg1 int
+		}
+		method30Returns struct {
+			result1 error
+		}
+		method30ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method30ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method31Stub        func(int) error
+		method31Mutex       sync.RWMutex
+		method31ArgsForCall []struct {
+			arg1 int
+		}
+		method31Returns struct {
+			result1 error
+		}
+		method31ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method31ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method32Stub        func(int) error
+		method32Mutex       sync.RWMutex
+		method32ArgsForCall []struct {
+			arg1 int
+		}
+		method32Returns struct {
+			result1 error
+		}
+		method32ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method32ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method33Stub        func(int) error
+		method33Mutex       sync.RWMutex
+		method33ArgsForCall []struct {
+			arg1 int
+		}
+		method33Returns struct {
+			result1 error
+		}
+		method33ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method33ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method34Stub        func(int) error
+		method34Mutex       sync.RWMutex
+		method34ArgsForCall []struct {
+			arg1 int
+		}
+		method34Returns struct {
+			result1 error
+		}
+		method34ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method34ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method35Stub        func(int) error
+		method35Mutex       sync.RWMutex
+		method35ArgsForCall []struct {
+			arg1 int
+		}
+		method35Returns struct {
+			result1 error
+		}
+		method35ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method35ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method36Stub        func(int) error
+		method36Mutex       sync.RWMutex
+		method36ArgsForCall []struct {
+			arg1 int
+		}
+		method36Returns struct {
+			result1 error
+		}
+		method36ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method36ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method37Stub        func(int) error
+		method37Mutex       sync.RWMutex
+		method37ArgsForCall []struct {
+			arg1 int
+		}
+		method37Returns struct {
+			result1 error
+		}
+		method37ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method37ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method38Stub        func(int) error
+		method38Mutex       sync.RWMutex
+		method38ArgsForCall []struct {
+			arg1 int
+		}
+		method38Returns struct {
+			result1 error
+		}
+		method38ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method38ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method39Stub        func(int) error
+		method39Mutex       sync.RWMutex
+		method39ArgsForCall []struct {
+			arg1 int
+		}
+		method39Returns struct {
+			result1 error
+		}
+		method39ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method39ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method40Stub        func(int) error
+		method40Mutex       sync.RWMutex
+		method40ArgsForCall []struct {
+			arg1 int
+		}
+		method40Returns struct {
+			result1 error
+		}
+		method40ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method40ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method41Stub        func(int) error
+		method41Mutex       sync.RWMutex
+		method41ArgsForCall []struct {
+			arg1 int
+		}
+		method41Returns struct {
+			result1 error
+		}
+		method41ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method41ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method42Stub        func(int) error
+		method42Mutex       sync.RWMutex
+		method42ArgsForCall []struct {
+			arg1 int
+		}
+		method42Returns struct {
+			result1 error
+		}
+		method42ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method42ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method43Stub        func(int) error
+		method43Mutex       sync.RWMutex
+		method43ArgsForCall []struct {
+			arg1 int
+		}
+		method43Returns struct {
+			result1 error
+		}
+		method43ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method43ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method44Stub        func(int) error
+		method44Mutex       sync.RWMutex
+		method44ArgsForCall []struct {
+			arg1 int
+		}
+		method44Returns struct {
+			result1 error
+		}
+		method44ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method44ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method45Stub        func(int) error
+		method45Mutex       sync.RWMutex
+		method45ArgsForCall []struct {
+			arg1 int
+		}
+		method45Returns struct {
+			result1 error
+		}
+		method45ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method45ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method46Stub        func(int) error
+		method46Mutex       sync.RWMutex
+		method46ArgsForCall []struct {
+			arg1 int
+		}
+		method46Returns struct {
+			result1 error
+		}
+		method46ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method46ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method47Stub        func(int) error
+		method47Mutex       sync.RWMutex
+		method47ArgsForCall []struct {
+			arg1 int
+		}
+		method47Returns struct {
+			result1 error
+		}
+		method47ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method47ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method48Stub        func(int) error
+		method48Mutex       sync.RWMutex
+		method48ArgsForCall []struct {
+			arg1 int
+		}
+		method48Returns struct {
+			result1 error
+		}
+		method48ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method48ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method49Stub        func(int) error
+		method49Mutex       sync.RWMutex
+		method49ArgsForCall []struct {
+			arg1 int
+		}
+		method49Returns struct {
+			result1 error
+		}
+		method49ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method49ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method50Stub        func(int) error
+		method50Mutex       sync.RWMutex
+		method50ArgsForCall []struct {
+			arg1 int
+		}
+		method50Returns struct {
+			result1 error
+		}
+		method50ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method50ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method51Stub        func(int) error
+		method51Mutex       sync.RWMutex
+		method51ArgsForCall []struct {
+			arg1 int
+		}
+		method51Returns struct {
+			result1 error
+		}
+		method51ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method51ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method52Stub        func(int) error
+		method52Mutex       sync.RWMutex
+		method52ArgsForCall []struct {
+			arg1 int
+		}
+		method52Returns struct {
+			result1 error
+		}
+		method52ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method52ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method53Stub        func(int) error
+		method53Mutex       sync.RWMutex
+		method53ArgsForCall []struct {
+			arg1 int
+		}
+		method53Returns struct {
+			result1 error
+		}
+		method53ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method53ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method54Stub        func(int) error
+		method54Mutex       sync.RWMutex
+		method54ArgsForCall []struct {
+			arg1 int
+		}
+		method54Returns struct {
+			result1 error
+		}
+		method54ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method54ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method55Stub        func(int) error
+		method55Mutex       sync.RWMutex
+		method55ArgsForCall []struct {
+			arg1 int
+		}
+		method55Returns struct {
+			result1 error
+		}
+		method55ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method55ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method56Stub        func(int) error
+		method56Mutex       sync.RWMutex
+		method56ArgsForCall []struct {
+			arg1 int
+		}
+		method56Returns struct {
+			result1 error
+		}
+		method56ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method56ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method57Stub        func(int) error
+		method57Mutex       sync.RWMutex
+		method57ArgsForCall []struct {
+			arg1 int
+		}
+		method57Returns struct {
+			result1 error
+		}
+		method57ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method57ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method58Stub        func(int) error
+		method58Mutex       sync.RWMutex
+		method58ArgsForCall []struct {
+			arg1 int
+		}
+		method58Returns struct {
+			result1 error
+		}
+		method58ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method58ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method59Stub        func(int) error
+		method59Mutex       sync.RWMutex
+		method59ArgsForCall []struct {
+			arg1 int
+		}
+		method59Returns struct {
+			result1 error
+		}
+		method59ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method59ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method60Stub        func(int) error
+		method60Mutex       sync.RWMutex
+		method60ArgsForCall []struct {
+			arg1 int
+		}
+		method60Returns struct {
+			result1 error
+		}
+		method60ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method60ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method61Stub        func(int) error
+		method61Mutex       sync.RWMutex
+		method61ArgsForCall []struct {
+			arg1 int
+		}
+		method61Returns struct {
+			result1 error
+		}
+		method61ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method61ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method62Stub        func(int) error
+		method62Mutex       sync.RWMutex
+		method62ArgsForCall []struct {
+			arg1 int
+		}
+		method62Returns struct {
+			result1 error
+		}
+		method62ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method62ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method63Stub        func(int) error
+		method63Mutex       sync.RWMutex
+		method63ArgsForCall []struct {
+			arg1 int
+		}
+		method63Returns struct {
+			result1 error
+		}
+		method63ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method63ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method64Stub        func(int) error
+		method64Mutex       sync.RWMutex
+		method64ArgsForCall []struct {
+			arg1 int
+		}
+		method64Returns struct {
+			result1 error
+		}
+		method64ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method64ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method65Stub        func(int) error
+		method65Mutex       sync.RWMutex
+		method65ArgsForCall []struct {
+			arg1 int
+		}
+		method65Returns struct {
+			result1 error
+		}
+		method65ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method65ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method66Stub        func(int) error
+		method66Mutex       sync.RWMutex
+		method66ArgsForCall []struct {
+			arg1 int
+		}
+		method66Returns struct {
+			result1 error
+		}
+		method66ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method66ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method67Stub        func(int) error
+		method67Mutex       sync.RWMutex
+		method67ArgsForCall []struct {
+			arg1 int
+		}
+		method67Returns struct {
+			result1 error
+		}
+		method67ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method67ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method68Stub        func(int) error
+		method68Mutex       sync.RWMutex
+		method68ArgsForCall []struct {
+			arg1 int
+		}
+		method68Returns struct {
+			result1 error
+		}
+		method68ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method68ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method69Stub        func(int) error
+		method69Mutex       sync.RWMutex
+		method69ArgsForCall []struct {
+			arg1 int
+		}
+		method69Returns struct {
+			result1 error
+		}
+		method69ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method69ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method70Stub        func(int) error
+		method70Mutex       sync.RWMutex
+		method70ArgsForCall []struct {
+			arg1 int
+		}
+		method70Returns struct {
+			result1 error
+		}
+		method70ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method70ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method71Stub        func(int) error
+		method71Mutex       sync.RWMutex
+		method71ArgsForCall []struct {
+			arg1 int
+		}
+		method71Returns struct {
+			result1 error
+		}
+		method71ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method71ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method72Stub        func(int) error
+		method72Mutex       sync.RWMutex
+		method72ArgsForCall []struct {
+			arg1 int
+		}
+		method72Returns struct {
+			result1 error
+		}
+		method72ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method72ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method73Stub        func(int) error
+		method73Mutex       sync.RWMutex
+		method73ArgsForCall []struct {
+			arg1 int
+		}
+		method73Returns struct {
+			result1 error
+		}
+		method73ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method73ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method74Stub        func(int) error
+		method74Mutex       sync.RWMutex
+		method74ArgsForCall []struct {
+			arg1 int
+		}
+		method74Returns struct {
+			result1 error
+		}
+		method74ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method74ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method75Stub        func(int) error
+		method75Mutex       sync.RWMutex
+		method75ArgsForCall []struct {
+			arg1 int
+		}
+		method75Returns struct {
+			result1 error
+		}
+		method75ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method75ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method76Stub        func(int) error
+		method76Mutex       sync.RWMutex
+		method76ArgsForCall []struct {
+			arg1 int
+		}
+		method76Returns struct {
+			result1 error
+		}
+		method76ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method76ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method77Stub        func(int) error
+		method77Mutex       sync.RWMutex
+		method77ArgsForCall []struct {
+			arg1 int
+		}
+		method77Returns struct {
+			result1 error
+		}
+		method77ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method77ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method78Stub        func(int) error
+		method78Mutex       sync.RWMutex
+		method78ArgsForCall []struct {
+			arg1 int
+		}
+		method78Returns struct {
+			result1 error
+		}
+		method78ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method78ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method79Stub        func(int) error
+		method79Mutex       sync.RWMutex
+		method79ArgsForCall []struct {
+			arg1 int
+		}
+		method79Returns struct {
+			result1 error
+		}
+		method79ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method79ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method80Stub        func(int) error
+		method80Mutex       sync.RWMutex
+		method80ArgsForCall []struct {
+			arg1 int
+		}
+		method80Returns struct {
+			result1 error
+		}
+		method80ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method80ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method81Stub        func(int) error
+		method81Mutex       sync.RWMutex
+		method81ArgsForCall []struct {
+			arg1 int
+		}
+		method81Returns struct {
+			result1 error
+		}
+		method81ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method81ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method82Stub        func(int) error
+		method82Mutex       sync.RWMutex
+		method82ArgsForCall []struct {
+			arg1 int
+		}
+		method82Returns struct {
+			result1 error
+		}
+		method82ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method82ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method83Stub        func(int) error
+		method83Mutex       sync.RWMutex
+		method83ArgsForCall []struct {
+			arg1 int
+		}
+		method83Returns struct {
+			result1 error
+		}
+		method83ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method83ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method84Stub        func(int) error
+		method84Mutex       sync.RWMutex
+		method84ArgsForCall []struct {
+			arg1 int
+		}
+		method84Returns struct {
+			result1 error
+		}
+		method84ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method84ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method85Stub        func(int) error
+		method85Mutex       sync.RWMutex
+		method85ArgsForCall []struct {
+			arg1 int
+		}
+		method85Returns struct {
+			result1 error
+		}
+		method85ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method85ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method86Stub        func(int) error
+		method86Mutex       sync.RWMutex
+		method86ArgsForCall []struct {
+			arg1 int
+		}
+		method86Returns struct {
+			result1 error
+		}
+		method86ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method86ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method87Stub        func(int) error
+		method87Mutex       sync.RWMutex
+		method87ArgsForCall []struct {
+			arg1 int
+		}
+		method87Returns struct {
+			result1 error
+		}
+		method87ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method87ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method88Stub        func(int) error
+		method88Mutex       sync.RWMutex
+		method88ArgsForCall []struct {
+			arg1 int
+		}
+		method88Returns struct {
+			result1 error
+		}
+		method88ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method88ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method89Stub        func(int) error
+		method89Mutex       sync.RWMutex
+		method89ArgsForCall []struct {
+			arg1 int
+		}
+		method89Returns struct {
+			result1 error
+		}
+		method89ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method89ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method90Stub        func(int) error
+		method90Mutex       sync.RWMutex
+		method90ArgsForCall []struct {
+			arg1 int
+		}
+		method90Returns struct {
+			result1 error
+		}
+		method90ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method90ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method91Stub        func(int) error
+		method91Mutex       sync.RWMutex
+		method91ArgsForCall []struct {
+			arg1 int
+		}
+		method91Returns struct {
+			result1 error
+		}
+		method91ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method91ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method92Stub        func(int) error
+		method92Mutex       sync.RWMutex
+		method92ArgsForCall []struct {
+			arg1 int
+		}
+		method92Returns struct {
+			result1 error
+		}
+		method92ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method92ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method93Stub        func(int) error
+		method93Mutex       sync.RWMutex
+		method93ArgsForCall []struct {
+			arg1 int
+		}
+		method93Returns struct {
+			result1 error
+		}
+		method93ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method93ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method94Stub        func(int) error
+		method94Mutex       sync.RWMutex
+		method94ArgsForCall []struct {
+			arg1 int
+		}
+		method94Returns struct {
+			result1 error
+		}
+		method94ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method94ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method95Stub        func(int) error
+		method95Mutex       sync.RWMutex
+		method95ArgsForCall []struct {
+			arg1 int
+		}
+		method95Returns struct {
+			result1 error
+		}
+		method95ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method95ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method96Stub        func(int) error
+		method96Mutex       sync.RWMutex
+		method96ArgsForCall []struct {
+			arg1 int
+		}
+		method96Returns struct {
+			result1 error
+		}
+		method96ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method96ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method97Stub        func(int) error
+		method97Mutex       sync.RWMutex
+		method97ArgsForCall []struct {
+			arg1 int
+		}
+		method97Returns struct {
+			result1 error
+		}
+		method97ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method97ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method98Stub        func(int) error
+		method98Mutex       sync.RWMutex
+		method98ArgsForCall []struct {
+			arg1 int
+		}
+		method98Returns struct {
+			result1 error
+		}
+		method98ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method98ResultsForCall map[int]struct {
+			result1 error
+		}
+		Method99Stub        func(int) error
+		method99Mutex       sync.RWMutex
+		method99ArgsForCall []struct {
+			arg1 int
+		}
+		method99Returns struct {
+			result1 error
+		}
+		method99ReturnsOnCall map[int]struct {
+			result1 error
+		}
+		method99ResultsForCall map[int]struct {
+			result1 error
+		}
+		invocations      map[string][][]interface{}
+		calls            []*FakeWideCall
+		callSeq          int
+		invocationsMutex sync.RWMutex
+	}
+	FakeWideCall struct {
+		Method  string
+		Seq     int
+		Args    []interface{}
+		Results []interface{}
+	}
+)
+
+func (fake *FakeWide) Method0(arg1 int) error {
+	fake.method0Mutex.Lock()
+	stub := fake.Method0Stub
+	callIndex := len(fake.method0ArgsForCall)
+	ret, specificReturn := fake.method0ReturnsOnCall[callIndex]
+	fakeReturns := fake.method0Returns
+	fake.method0ArgsForCall = append(fake.method0ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method0Mutex.Unlock()
+	call := fake.recordInvocation("Method0", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method0Mutex.Lock()
+	if fake.method0ResultsForCall == nil {
+		fake.method0ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method0ResultsForCall[callIndex] = results
+	fake.method0Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method0CallCount() int {
+	fake.method0Mutex.RLock()
+	defer fake.method0Mutex.RUnlock()
+	return len(fake.method0ArgsForCall)
+}
+
+func (fake *FakeWide) Method0ArgsForCall(i int) int {
+	fake.method0Mutex.RLock()
+	defer fake.method0Mutex.RUnlock()
+	return fake.method0ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method0ResultsForCall(i int) error {
+	fake.method0Mutex.RLock()
+	defer fake.method0Mutex.RUnlock()
+	return fake.method0ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method0Calls(stub func(int) error) {
+	fake.method0Mutex.Lock()
+	defer fake.method0Mutex.Unlock()
+	fake.Method0Stub = stub
+}
+
+func (fake *FakeWide) Method0Returns(result1 error) {
+	fake.method0Mutex.Lock()
+	defer fake.method0Mutex.Unlock()
+	fake.Method0Stub = nil
+	fake.method0Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method0ReturnsOnCall(i int, result1 error) {
+	fake.method0Mutex.Lock()
+	defer fake.method0Mutex.Unlock()
+	fake.Method0Stub = nil
+	if fake.method0ReturnsOnCall == nil {
+		fake.method0ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method0ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method1(arg1 int) error {
+	fake.method1Mutex.Lock()
+	stub := fake.Method1Stub
+	callIndex := len(fake.method1ArgsForCall)
+	ret, specificReturn := fake.method1ReturnsOnCall[callIndex]
+	fakeReturns := fake.method1Returns
+	fake.method1ArgsForCall = append(fake.method1ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method1Mutex.Unlock()
+	call := fake.recordInvocation("Method1", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method1Mutex.Lock()
+	if fake.method1ResultsForCall == nil {
+		fake.method1ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method1ResultsForCall[callIndex] = results
+	fake.method1Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method1CallCount() int {
+	fake.method1Mutex.RLock()
+	defer fake.method1Mutex.RUnlock()
+	return len(fake.method1ArgsForCall)
+}
+
+func (fake *FakeWide) Method1ArgsForCall(i int) int {
+	fake.method1Mutex.RLock()
+	defer fake.method1Mutex.RUnlock()
+	return fake.method1ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method1ResultsForCall(i int) error {
+	fake.method1Mutex.RLock()
+	defer fake.method1Mutex.RUnlock()
+	return fake.method1ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method1Calls(stub func(int) error) {
+	fake.method1Mutex.Lock()
+	defer fake.method1Mutex.Unlock()
+	fake.Method1Stub = stub
+}
+
+func (fake *FakeWide) Method1Returns(result1 error) {
+	fake.method1Mutex.Lock()
+	defer fake.method1Mutex.Unlock()
+	fake.Method1Stub = nil
+	fake.method1Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method1ReturnsOnCall(i int, result1 error) {
+	fake.method1Mutex.Lock()
+	defer fake.method1Mutex.Unlock()
+	fake.Method1Stub = nil
+	if fake.method1ReturnsOnCall == nil {
+		fake.method1ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method1ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method2(arg1 int) error {
+	fake.method2Mutex.Lock()
+	stub := fake.Method2Stub
+	callIndex := len(fake.method2ArgsForCall)
+	ret, specificReturn := fake.method2ReturnsOnCall[callIndex]
+	fakeReturns := fake.method2Returns
+	fake.method2ArgsForCall = append(fake.method2ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method2Mutex.Unlock()
+	call := fake.recordInvocation("Method2", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method2Mutex.Lock()
+	if fake.method2ResultsForCall == nil {
+		fake.method2ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method2ResultsForCall[callIndex] = results
+	fake.method2Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method2CallCount() int {
+	fake.method2Mutex.RLock()
+	defer fake.method2Mutex.RUnlock()
+	return len(fake.method2ArgsForCall)
+}
+
+func (fake *FakeWide) Method2ArgsForCall(i int) int {
+	fake.method2Mutex.RLock()
+	defer fake.method2Mutex.RUnlock()
+	return fake.method2ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method2ResultsForCall(i int) error {
+	fake.method2Mutex.RLock()
+	defer fake.method2Mutex.RUnlock()
+	return fake.method2ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method2Calls(stub func(int) error) {
+	fake.method2Mutex.Lock()
+	defer fake.method2Mutex.Unlock()
+	fake.Method2Stub = stub
+}
+
+func (fake *FakeWide) Method2Returns(result1 error) {
+	fake.method2Mutex.Lock()
+	defer fake.method2Mutex.Unlock()
+	fake.Method2Stub = nil
+	fake.method2Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method2ReturnsOnCall(i int, result1 error) {
+	fake.method2Mutex.Lock()
+	defer fake.method2Mutex.Unlock()
+	fake.Method2Stub = nil
+	if fake.method2ReturnsOnCall == nil {
+		fake.method2ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method2ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method3(arg1 int) error {
+	fake.method3Mutex.Lock()
+	stub := fake.Method3Stub
+	callIndex := len(fake.method3ArgsForCall)
+	ret, specificReturn := fake.method3ReturnsOnCall[callIndex]
+	fakeReturns := fake.method3Returns
+	fake.method3ArgsForCall = append(fake.method3ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method3Mutex.Unlock()
+	call := fake.recordInvocation("Method3", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method3Mutex.Lock()
+	if fake.method3ResultsForCall == nil {
+		fake.method3ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method3ResultsForCall[callIndex] = results
+	fake.method3Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method3CallCount() int {
+	fake.method3Mutex.RLock()
+	defer fake.method3Mutex.RUnlock()
+	return len(fake.method3ArgsForCall)
+}
+
+func (fake *FakeWide) Method3ArgsForCall(i int) int {
+	fake.method3Mutex.RLock()
+	defer fake.method3Mutex.RUnlock()
+	return fake.method3ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method3ResultsForCall(i int) error {
+	fake.method3Mutex.RLock()
+	defer fake.method3Mutex.RUnlock()
+	return fake.method3ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method3Calls(stub func(int) error) {
+	fake.method3Mutex.Lock()
+	defer fake.method3Mutex.Unlock()
+	fake.Method3Stub = stub
+}
+
+func (fake *FakeWide) Method3Returns(result1 error) {
+	fake.method3Mutex.Lock()
+	defer fake.method3Mutex.Unlock()
+	fake.Method3Stub = nil
+	fake.method3Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method3ReturnsOnCall(i int, result1 error) {
+	fake.method3Mutex.Lock()
+	defer fake.method3Mutex.Unlock()
+	fake.Method3Stub = nil
+	if fake.method3ReturnsOnCall == nil {
+		fake.method3ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method3ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method4(arg1 int) error {
+	fake.method4Mutex.Lock()
+	stub := fake.Method4Stub
+	callIndex := len(fake.method4ArgsForCall)
+	ret, specificReturn := fake.method4ReturnsOnCall[callIndex]
+	fakeReturns := fake.method4Returns
+	fake.method4ArgsForCall = append(fake.method4ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method4Mutex.Unlock()
+	call := fake.recordInvocation("Method4", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method4Mutex.Lock()
+	if fake.method4ResultsForCall == nil {
+		fake.method4ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method4ResultsForCall[callIndex] = results
+	fake.method4Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method4CallCount() int {
+	fake.method4Mutex.RLock()
+	defer fake.method4Mutex.RUnlock()
+	return len(fake.method4ArgsForCall)
+}
+
+func (fake *FakeWide) Method4ArgsForCall(i int) int {
+	fake.method4Mutex.RLock()
+	defer fake.method4Mutex.RUnlock()
+	return fake.method4ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method4ResultsForCall(i int) error {
+	fake.method4Mutex.RLock()
+	defer fake.method4Mutex.RUnlock()
+	return fake.method4ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method4Calls(stub func(int) error) {
+	fake.method4Mutex.Lock()
+	defer fake.method4Mutex.Unlock()
+	fake.Method4Stub = stub
+}
+
+func (fake *FakeWide) Method4Returns(result1 error) {
+	fake.method4Mutex.Lock()
+	defer fake.method4Mutex.Unlock()
+	fake.Method4Stub = nil
+	fake.method4Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method4ReturnsOnCall(i int, result1 error) {
+	fake.method4Mutex.Lock()
+	defer fake.method4Mutex.Unlock()
+	fake.Method4Stub = nil
+	if fake.method4ReturnsOnCall == nil {
+		fake.method4ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method4ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method5(arg1 int) error {
+	fake.method5Mutex.Lock()
+	stub := fake.Method5Stub
+	callIndex := len(fake.method5ArgsForCall)
+	ret, specificReturn := fake.method5ReturnsOnCall[callIndex]
+	fakeReturns := fake.method5Returns
+	fake.method5ArgsForCall = append(fake.method5ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method5Mutex.Unlock()
+	call := fake.recordInvocation("Method5", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method5Mutex.Lock()
+	if fake.method5ResultsForCall == nil {
+		fake.method5ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method5ResultsForCall[callIndex] = results
+	fake.method5Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method5CallCount() int {
+	fake.method5Mutex.RLock()
+	defer fake.method5Mutex.RUnlock()
+	return len(fake.method5ArgsForCall)
+}
+
+func (fake *FakeWide) Method5ArgsForCall(i int) int {
+	fake.method5Mutex.RLock()
+	defer fake.method5Mutex.RUnlock()
+	return fake.method5ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method5ResultsForCall(i int) error {
+	fake.method5Mutex.RLock()
+	defer fake.method5Mutex.RUnlock()
+	return fake.method5ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method5Calls(stub func(int) error) {
+	fake.method5Mutex.Lock()
+	defer fake.method5Mutex.Unlock()
+	fake.Method5Stub = stub
+}
+
+func (fake *FakeWide) Method5Returns(result1 error) {
+	fake.method5Mutex.Lock()
+	defer fake.method5Mutex.Unlock()
+	fake.Method5Stub = nil
+	fake.method5Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method5ReturnsOnCall(i int, result1 error) {
+	fake.method5Mutex.Lock()
+	defer fake.method5Mutex.Unlock()
+	fake.Method5Stub = nil
+	if fake.method5ReturnsOnCall == nil {
+		fake.method5ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method5ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method6(arg1 int) error {
+	fake.method6Mutex.Lock()
+	stub := fake.Method6Stub
+	callIndex := len(fake.method6ArgsForCall)
+	ret, specificReturn := fake.method6ReturnsOnCall[callIndex]
+	fakeReturns := fake.method6Returns
+	fake.method6ArgsForCall = append(fake.method6ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method6Mutex.Unlock()
+	call := fake.recordInvocation("Method6", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method6Mutex.Lock()
+	if fake.method6ResultsForCall == nil {
+		fake.method6ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method6ResultsForCall[callIndex] = results
+	fake.method6Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method6CallCount() int {
+	fake.method6Mutex.RLock()
+	defer fake.method6Mutex.RUnlock()
+	return len(fake.method6ArgsForCall)
+}
+
+func (fake *FakeWide) Method6ArgsForCall(i int) int {
+	fake.method6Mutex.RLock()
+	defer fake.method6Mutex.RUnlock()
+	return fake.method6ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method6ResultsForCall(i int) error {
+	fake.method6Mutex.RLock()
+	defer fake.method6Mutex.RUnlock()
+	return fake.method6ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method6Calls(stub func(int) error) {
+	fake.method6Mutex.Lock()
+	defer fake.method6Mutex.Unlock()
+	fake.Method6Stub = stub
+}
+
+func (fake *FakeWide) Method6Returns(result1 error) {
+	fake.method6Mutex.Lock()
+	defer fake.method6Mutex.Unlock()
+	fake.Method6Stub = nil
+	fake.method6Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method6ReturnsOnCall(i int, result1 error) {
+	fake.method6Mutex.Lock()
+	defer fake.method6Mutex.Unlock()
+	fake.Method6Stub = nil
+	if fake.method6ReturnsOnCall == nil {
+		fake.method6ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method6ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method7(arg1 int) error {
+	fake.method7Mutex.Lock()
+	stub := fake.Method7Stub
+	callIndex := len(fake.method7ArgsForCall)
+	ret, specificReturn := fake.method7ReturnsOnCall[callIndex]
+	fakeReturns := fake.method7Returns
+	fake.method7ArgsForCall = append(fake.method7ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method7Mutex.Unlock()
+	call := fake.recordInvocation("Method7", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method7Mutex.Lock()
+	if fake.method7ResultsForCall == nil {
+		fake.method7ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method7ResultsForCall[callIndex] = results
+	fake.method7Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method7CallCount() int {
+	fake.method7Mutex.RLock()
+	defer fake.method7Mutex.RUnlock()
+	return len(fake.method7ArgsForCall)
+}
+
+func (fake *FakeWide) Method7ArgsForCall(i int) int {
+	fake.method7Mutex.RLock()
+	defer fake.method7Mutex.RUnlock()
+	return fake.method7ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method7ResultsForCall(i int) error {
+	fake.method7Mutex.RLock()
+	defer fake.method7Mutex.RUnlock()
+	return fake.method7ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method7Calls(stub func(int) error) {
+	fake.method7Mutex.Lock()
+	defer fake.method7Mutex.Unlock()
+	fake.Method7Stub = stub
+}
+
+func (fake *FakeWide) Method7Returns(result1 error) {
+	fake.method7Mutex.Lock()
+	defer fake.method7Mutex.Unlock()
+	fake.Method7Stub = nil
+	fake.method7Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method7ReturnsOnCall(i int, result1 error) {
+	fake.method7Mutex.Lock()
+	defer fake.method7Mutex.Unlock()
+	fake.Method7Stub = nil
+	if fake.method7ReturnsOnCall == nil {
+		fake.method7ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method7ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method8(arg1 int) error {
+	fake.method8Mutex.Lock()
+	stub := fake.Method8Stub
+	callIndex := len(fake.method8ArgsForCall)
+	ret, specificReturn := fake.method8ReturnsOnCall[callIndex]
+	fakeReturns := fake.method8Returns
+	fake.method8ArgsForCall = append(fake.method8ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method8Mutex.Unlock()
+	call := fake.recordInvocation("Method8", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method8Mutex.Lock()
+	if fake.method8ResultsForCall == nil {
+		fake.method8ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method8ResultsForCall[callIndex] = results
+	fake.method8Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method8CallCount() int {
+	fake.method8Mutex.RLock()
+	defer fake.method8Mutex.RUnlock()
+	return len(fake.method8ArgsForCall)
+}
+
+func (fake *FakeWide) Method8ArgsForCall(i int) int {
+	fake.method8Mutex.RLock()
+	defer fake.method8Mutex.RUnlock()
+	return fake.method8ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method8ResultsForCall(i int) error {
+	fake.method8Mutex.RLock()
+	defer fake.method8Mutex.RUnlock()
+	return fake.method8ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method8Calls(stub func(int) error) {
+	fake.method8Mutex.Lock()
+	defer fake.method8Mutex.Unlock()
+	fake.Method8Stub = stub
+}
+
+func (fake *FakeWide) Method8Returns(result1 error) {
+	fake.method8Mutex.Lock()
+	defer fake.method8Mutex.Unlock()
+	fake.Method8Stub = nil
+	fake.method8Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method8ReturnsOnCall(i int, result1 error) {
+	fake.method8Mutex.Lock()
+	defer fake.method8Mutex.Unlock()
+	fake.Method8Stub = nil
+	if fake.method8ReturnsOnCall == nil {
+		fake.method8ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method8ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method9(arg1 int) error {
+	fake.method9Mutex.Lock()
+	stub := fake.Method9Stub
+	callIndex := len(fake.method9ArgsForCall)
+	ret, specificReturn := fake.method9ReturnsOnCall[callIndex]
+	fakeReturns := fake.method9Returns
+	fake.method9ArgsForCall = append(fake.method9ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method9Mutex.Unlock()
+	call := fake.recordInvocation("Method9", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method9Mutex.Lock()
+	if fake.method9ResultsForCall == nil {
+		fake.method9ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method9ResultsForCall[callIndex] = results
+	fake.method9Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method9CallCount() int {
+	fake.method9Mutex.RLock()
+	defer fake.method9Mutex.RUnlock()
+	return len(fake.method9ArgsForCall)
+}
+
+func (fake *FakeWide) Method9ArgsForCall(i int) int {
+	fake.method9Mutex.RLock()
+	defer fake.method9Mutex.RUnlock()
+	return fake.method9ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method9ResultsForCall(i int) error {
+	fake.method9Mutex.RLock()
+	defer fake.method9Mutex.RUnlock()
+	return fake.method9ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method9Calls(stub func(int) error) {
+	fake.method9Mutex.Lock()
+	defer fake.method9Mutex.Unlock()
+	fake.Method9Stub = stub
+}
+
+func (fake *FakeWide) Method9Returns(result1 error) {
+	fake.method9Mutex.Lock()
+	defer fake.method9Mutex.Unlock()
+	fake.Method9Stub = nil
+	fake.method9Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method9ReturnsOnCall(i int, result1 error) {
+	fake.method9Mutex.Lock()
+	defer fake.method9Mutex.Unlock()
+	fake.Method9Stub = nil
+	if fake.method9ReturnsOnCall == nil {
+		fake.method9ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method9ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method10(arg1 int) error {
+	fake.method10Mutex.Lock()
+	stub := fake.Method10Stub
+	callIndex := len(fake.method10ArgsForCall)
+	ret, specificReturn := fake.method10ReturnsOnCall[callIndex]
+	fakeReturns := fake.method10Returns
+	fake.method10ArgsForCall = append(fake.method10ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method10Mutex.Unlock()
+	call := fake.recordInvocation("Method10", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method10Mutex.Lock()
+	if fake.method10ResultsForCall == nil {
+		fake.method10ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method10ResultsForCall[callIndex] = results
+	fake.method10Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method10CallCount() int {
+	fake.method10Mutex.RLock()
+	defer fake.method10Mutex.RUnlock()
+	return len(fake.method10ArgsForCall)
+}
+
+func (fake *FakeWide) Method10ArgsForCall(i int) int {
+	fake.method10Mutex.RLock()
+	defer fake.method10Mutex.RUnlock()
+	return fake.method10ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method10ResultsForCall(i int) error {
+	fake.method10Mutex.RLock()
+	defer fake.method10Mutex.RUnlock()
+	return fake.method10ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method10Calls(stub func(int) error) {
+	fake.method10Mutex.Lock()
+	defer fake.method10Mutex.Unlock()
+	fake.Method10Stub = stub
+}
+
+func (fake *FakeWide) Method10Returns(result1 error) {
+	fake.method10Mutex.Lock()
+	defer fake.method10Mutex.Unlock()
+	fake.Method10Stub = nil
+	fake.method10Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method10ReturnsOnCall(i int, result1 error) {
+	fake.method10Mutex.Lock()
+	defer fake.method10Mutex.Unlock()
+	fake.Method10Stub = nil
+	if fake.method10ReturnsOnCall == nil {
+		fake.method10ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method10ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method11(arg1 int) error {
+	fake.method11Mutex.Lock()
+	stub := fake.Method11Stub
+	callIndex := len(fake.method11ArgsForCall)
+	ret, specificReturn := fake.method11ReturnsOnCall[callIndex]
+	fakeReturns := fake.method11Returns
+	fake.method11ArgsForCall = append(fake.method11ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method11Mutex.Unlock()
+	call := fake.recordInvocation("Method11", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method11Mutex.Lock()
+	if fake.method11ResultsForCall == nil {
+		fake.method11ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method11ResultsForCall[callIndex] = results
+	fake.method11Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method11CallCount() int {
+	fake.method11Mutex.RLock()
+	defer fake.method11Mutex.RUnlock()
+	return len(fake.method11ArgsForCall)
+}
+
+func (fake *FakeWide) Method11ArgsForCall(i int) int {
+	fake.method11Mutex.RLock()
+	defer fake.method11Mutex.RUnlock()
+	return fake.method11ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method11ResultsForCall(i int) error {
+	fake.method11Mutex.RLock()
+	defer fake.method11Mutex.RUnlock()
+	return fake.method11ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method11Calls(stub func(int) error) {
+	fake.method11Mutex.Lock()
+	defer fake.method11Mutex.Unlock()
+	fake.Method11Stub = stub
+}
+
+func (fake *FakeWide) Method11Returns(result1 error) {
+	fake.method11Mutex.Lock()
+	defer fake.method11Mutex.Unlock()
+	fake.Method11Stub = nil
+	fake.method11Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method11ReturnsOnCall(i int, result1 error) {
+	fake.method11Mutex.Lock()
+	defer fake.method11Mutex.Unlock()
+	fake.Method11Stub = nil
+	if fake.method11ReturnsOnCall == nil {
+		fake.method11ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method11ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method12(arg1 int) error {
+	fake.method12Mutex.Lock()
+	stub := fake.Method12Stub
+	callIndex := len(fake.method12ArgsForCall)
+	ret, specificReturn := fake.method12ReturnsOnCall[callIndex]
+	fakeReturns := fake.method12Returns
+	fake.method12ArgsForCall = append(fake.method12ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method12Mutex.Unlock()
+	call := fake.recordInvocation("Method12", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method12Mutex.Lock()
+	if fake.method12ResultsForCall == nil {
+		fake.method12ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method12ResultsForCall[callIndex] = results
+	fake.method12Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method12CallCount() int {
+	fake.method12Mutex.RLock()
+	defer fake.method12Mutex.RUnlock()
+	return len(fake.method12ArgsForCall)
+}
+
+func (fake *FakeWide) Method12ArgsForCall(i int) int {
+	fake.method12Mutex.RLock()
+	defer fake.method12Mutex.RUnlock()
+	return fake.method12ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method12ResultsForCall(i int) error {
+	fake.method12Mutex.RLock()
+	defer fake.method12Mutex.RUnlock()
+	return fake.method12ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method12Calls(stub func(int) error) {
+	fake.method12Mutex.Lock()
+	defer fake.method12Mutex.Unlock()
+	fake.Method12Stub = stub
+}
+
+func (fake *FakeWide) Method12Returns(result1 error) {
+	fake.method12Mutex.Lock()
+	defer fake.method12Mutex.Unlock()
+	fake.Method12Stub = nil
+	fake.method12Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method12ReturnsOnCall(i int, result1 error) {
+	fake.method12Mutex.Lock()
+	defer fake.method12Mutex.Unlock()
+	fake.Method12Stub = nil
+	if fake.method12ReturnsOnCall == nil {
+		fake.method12ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method12ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method13(arg1 int) error {
+	fake.method13Mutex.Lock()
+	stub := fake.Method13Stub
+	callIndex := len(fake.method13ArgsForCall)
+	ret, specificReturn := fake.method13ReturnsOnCall[callIndex]
+	fakeReturns := fake.method13Returns
+	fake.method13ArgsForCall = append(fake.method13ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method13Mutex.Unlock()
+	call := fake.recordInvocation("Method13", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method13Mutex.Lock()
+	if fake.method13ResultsForCall == nil {
+		fake.method13ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method13ResultsForCall[callIndex] = results
+	fake.method13Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method13CallCount() int {
+	fake.method13Mutex.RLock()
+	defer fake.method13Mutex.RUnlock()
+	return len(fake.method13ArgsForCall)
+}
+
+func (fake *FakeWide) Method13ArgsForCall(i int) int {
+	fake.method13Mutex.RLock()
+	defer fake.method13Mutex.RUnlock()
+	return fake.method13ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method13ResultsForCall(i int) error {
+	fake.method13Mutex.RLock()
+	defer fake.method13Mutex.RUnlock()
+	return fake.method13ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method13Calls(stub func(int) error) {
+	fake.method13Mutex.Lock()
+	defer fake.method13Mutex.Unlock()
+	fake.Method13Stub = stub
+}
+
+func (fake *FakeWide) Method13Returns(result1 error) {
+	fake.method13Mutex.Lock()
+	defer fake.method13Mutex.Unlock()
+	fake.Method13Stub = nil
+	fake.method13Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method13ReturnsOnCall(i int, result1 error) {
+	fake.method13Mutex.Lock()
+	defer fake.method13Mutex.Unlock()
+	fake.Method13Stub = nil
+	if fake.method13ReturnsOnCall == nil {
+		fake.method13ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method13ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method14(arg1 int) error {
+	fake.method14Mutex.Lock()
+	stub := fake.Method14Stub
+	callIndex := len(fake.method14ArgsForCall)
+	ret, specificReturn := fake.method14ReturnsOnCall[callIndex]
+	fakeReturns := fake.method14Returns
+	fake.method14ArgsForCall = append(fake.method14ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method14Mutex.Unlock()
+	call := fake.recordInvocation("Method14", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method14Mutex.Lock()
+	if fake.method14ResultsForCall == nil {
+		fake.method14ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method14ResultsForCall[callIndex] = results
+	fake.method14Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method14CallCount() int {
+	fake.method14Mutex.RLock()
+	defer fake.method14Mutex.RUnlock()
+	return len(fake.method14ArgsForCall)
+}
+
+func (fake *FakeWide) Method14ArgsForCall(i int) int {
+	fake.method14Mutex.RLock()
+	defer fake.method14Mutex.RUnlock()
+	return fake.method14ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method14ResultsForCall(i int) error {
+	fake.method14Mutex.RLock()
+	defer fake.method14Mutex.RUnlock()
+	return fake.method14ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method14Calls(stub func(int) error) {
+	fake.method14Mutex.Lock()
+	defer fake.method14Mutex.Unlock()
+	fake.Method14Stub = stub
+}
+
+func (fake *FakeWide) Method14Returns(result1 error) {
+	fake.method14Mutex.Lock()
+	defer fake.method14Mutex.Unlock()
+	fake.Method14Stub = nil
+	fake.method14Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method14ReturnsOnCall(i int, result1 error) {
+	fake.method14Mutex.Lock()
+	defer fake.method14Mutex.Unlock()
+	fake.Method14Stub = nil
+	if fake.method14ReturnsOnCall == nil {
+		fake.method14ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method14ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method15(arg1 int) error {
+	fake.method15Mutex.Lock()
+	stub := fake.Method15Stub
+	callIndex := len(fake.method15ArgsForCall)
+	ret, specificReturn := fake.method15ReturnsOnCall[callIndex]
+	fakeReturns := fake.method15Returns
+	fake.method15ArgsForCall = append(fake.method15ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method15Mutex.Unlock()
+	call := fake.recordInvocation("Method15", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method15Mutex.Lock()
+	if fake.method15ResultsForCall == nil {
+		fake.method15ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method15ResultsForCall[callIndex] = results
+	fake.method15Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method15CallCount() int {
+	fake.method15Mutex.RLock()
+	defer fake.method15Mutex.RUnlock()
+	return len(fake.method15ArgsForCall)
+}
+
+func (fake *FakeWide) Method15ArgsForCall(i int) int {
+	fake.method15Mutex.RLock()
+	defer fake.method15Mutex.RUnlock()
+	return fake.method15ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method15ResultsForCall(i int) error {
+	fake.method15Mutex.RLock()
+	defer fake.method15Mutex.RUnlock()
+	return fake.method15ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method15Calls(stub func(int) error) {
+	fake.method15Mutex.Lock()
+	defer fake.method15Mutex.Unlock()
+	fake.Method15Stub = stub
+}
+
+func (fake *FakeWide) Method15Returns(result1 error) {
+	fake.method15Mutex.Lock()
+	defer fake.method15Mutex.Unlock()
+	fake.Method15Stub = nil
+	fake.method15Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method15ReturnsOnCall(i int, result1 error) {
+	fake.method15Mutex.Lock()
+	defer fake.method15Mutex.Unlock()
+	fake.Method15Stub = nil
+	if fake.method15ReturnsOnCall == nil {
+		fake.method15ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method15ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method16(arg1 int) error {
+	fake.method16Mutex.Lock()
+	stub := fake.Method16Stub
+	callIndex := len(fake.method16ArgsForCall)
+	ret, specificReturn := fake.method16ReturnsOnCall[callIndex]
+	fakeReturns := fake.method16Returns
+	fake.method16ArgsForCall = append(fake.method16ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method16Mutex.Unlock()
+	call := fake.recordInvocation("Method16", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method16Mutex.Lock()
+	if fake.method16ResultsForCall == nil {
+		fake.method16ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method16ResultsForCall[callIndex] = results
+	fake.method16Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method16CallCount() int {
+	fake.method16Mutex.RLock()
+	defer fake.method16Mutex.RUnlock()
+	return len(fake.method16ArgsForCall)
+}
+
+func (fake *FakeWide) Method16ArgsForCall(i int) int {
+	fake.method16Mutex.RLock()
+	defer fake.method16Mutex.RUnlock()
+	return fake.method16ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method16ResultsForCall(i int) error {
+	fake.method16Mutex.RLock()
+	defer fake.method16Mutex.RUnlock()
+	return fake.method16ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method16Calls(stub func(int) error) {
+	fake.method16Mutex.Lock()
+	defer fake.method16Mutex.Unlock()
+	fake.Method16Stub = stub
+}
+
+func (fake *FakeWide) Method16Returns(result1 error) {
+	fake.method16Mutex.Lock()
+	defer fake.method16Mutex.Unlock()
+	fake.Method16Stub = nil
+	fake.method16Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method16ReturnsOnCall(i int, result1 error) {
+	fake.method16Mutex.Lock()
+	defer fake.method16Mutex.Unlock()
+	fake.Method16Stub = nil
+	if fake.method16ReturnsOnCall == nil {
+		fake.method16ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method16ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method17(arg1 int) error {
+	fake.method17Mutex.Lock()
+	stub := fake.Method17Stub
+	callIndex := len(fake.method17ArgsForCall)
+	ret, specificReturn := fake.method17ReturnsOnCall[callIndex]
+	fakeReturns := fake.method17Returns
+	fake.method17ArgsForCall = append(fake.method17ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method17Mutex.Unlock()
+	call := fake.recordInvocation("Method17", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method17Mutex.Lock()
+	if fake.method17ResultsForCall == nil {
+		fake.method17ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method17ResultsForCall[callIndex] = results
+	fake.method17Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method17CallCount() int {
+	fake.method17Mutex.RLock()
+	defer fake.method17Mutex.RUnlock()
+	return len(fake.method17ArgsForCall)
+}
+
+func (fake *FakeWide) Method17ArgsForCall(i int) int {
+	fake.method17Mutex.RLock()
+	defer fake.method17Mutex.RUnlock()
+	return fake.method17ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method17ResultsForCall(i int) error {
+	fake.method17Mutex.RLock()
+	defer fake.method17Mutex.RUnlock()
+	return fake.method17ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method17Calls(stub func(int) error) {
+	fake.method17Mutex.Lock()
+	defer fake.method17Mutex.Unlock()
+	fake.Method17Stub = stub
+}
+
+func (fake *FakeWide) Method17Returns(result1 error) {
+	fake.method17Mutex.Lock()
+	defer fake.method17Mutex.Unlock()
+	fake.Method17Stub = nil
+	fake.method17Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method17ReturnsOnCall(i int, result1 error) {
+	fake.method17Mutex.Lock()
+	defer fake.method17Mutex.Unlock()
+	fake.Method17Stub = nil
+	if fake.method17ReturnsOnCall == nil {
+		fake.method17ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method17ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method18(arg1 int) error {
+	fake.method18Mutex.Lock()
+	stub := fake.Method18Stub
+	callIndex := len(fake.method18ArgsForCall)
+	ret, specificReturn := fake.method18ReturnsOnCall[callIndex]
+	fakeReturns := fake.method18Returns
+	fake.method18ArgsForCall = append(fake.method18ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method18Mutex.Unlock()
+	call := fake.recordInvocation("Method18", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method18Mutex.Lock()
+	if fake.method18ResultsForCall == nil {
+		fake.method18ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method18ResultsForCall[callIndex] = results
+	fake.method18Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method18CallCount() int {
+	fake.method18Mutex.RLock()
+	defer fake.method18Mutex.RUnlock()
+	return len(fake.method18ArgsForCall)
+}
+
+func (fake *FakeWide) Method18ArgsForCall(i int) int {
+	fake.method18Mutex.RLock()
+	defer fake.method18Mutex.RUnlock()
+	return fake.method18ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method18ResultsForCall(i int) error {
+	fake.method18Mutex.RLock()
+	defer fake.method18Mutex.RUnlock()
+	return fake.method18ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method18Calls(stub func(int) error) {
+	fake.method18Mutex.Lock()
+	defer fake.method18Mutex.Unlock()
+	fake.Method18Stub = stub
+}
+
+func (fake *FakeWide) Method18Returns(result1 error) {
+	fake.method18Mutex.Lock()
+	defer fake.method18Mutex.Unlock()
+	fake.Method18Stub = nil
+	fake.method18Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method18ReturnsOnCall(i int, result1 error) {
+	fake.method18Mutex.Lock()
+	defer fake.method18Mutex.Unlock()
+	fake.Method18Stub = nil
+	if fake.method18ReturnsOnCall == nil {
+		fake.method18ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method18ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method19(arg1 int) error {
+	fake.method19Mutex.Lock()
+	stub := fake.Method19Stub
+	callIndex := len(fake.method19ArgsForCall)
+	ret, specificReturn := fake.method19ReturnsOnCall[callIndex]
+	fakeReturns := fake.method19Returns
+	fake.method19ArgsForCall = append(fake.method19ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method19Mutex.Unlock()
+	call := fake.recordInvocation("Method19", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method19Mutex.Lock()
+	if fake.method19ResultsForCall == nil {
+		fake.method19ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method19ResultsForCall[callIndex] = results
+	fake.method19Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method19CallCount() int {
+	fake.method19Mutex.RLock()
+	defer fake.method19Mutex.RUnlock()
+	return len(fake.method19ArgsForCall)
+}
+
+func (fake *FakeWide) Method19ArgsForCall(i int) int {
+	fake.method19Mutex.RLock()
+	defer fake.method19Mutex.RUnlock()
+	return fake.method19ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method19ResultsForCall(i int) error {
+	fake.method19Mutex.RLock()
+	defer fake.method19Mutex.RUnlock()
+	return fake.method19ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method19Calls(stub func(int) error) {
+	fake.method19Mutex.Lock()
+	defer fake.method19Mutex.Unlock()
+	fake.Method19Stub = stub
+}
+
+func (fake *FakeWide) Method19Returns(result1 error) {
+	fake.method19Mutex.Lock()
+	defer fake.method19Mutex.Unlock()
+	fake.Method19Stub = nil
+	fake.method19Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method19ReturnsOnCall(i int, result1 error) {
+	fake.method19Mutex.Lock()
+	defer fake.method19Mutex.Unlock()
+	fake.Method19Stub = nil
+	if fake.method19ReturnsOnCall == nil {
+		fake.method19ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method19ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method20(arg1 int) error {
+	fake.method20Mutex.Lock()
+	stub := fake.Method20Stub
+	callIndex := len(fake.method20ArgsForCall)
+	ret, specificReturn := fake.method20ReturnsOnCall[callIndex]
+	fakeReturns := fake.method20Returns
+	fake.method20ArgsForCall = append(fake.method20ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method20Mutex.Unlock()
+	call := fake.recordInvocation("Method20", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method20Mutex.Lock()
+	if fake.method20ResultsForCall == nil {
+		fake.method20ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method20ResultsForCall[callIndex] = results
+	fake.method20Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method20CallCount() int {
+	fake.method20Mutex.RLock()
+	defer fake.method20Mutex.RUnlock()
+	return len(fake.method20ArgsForCall)
+}
+
+func (fake *FakeWide) Method20ArgsForCall(i int) int {
+	fake.method20Mutex.RLock()
+	defer fake.method20Mutex.RUnlock()
+	return fake.method20ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method20ResultsForCall(i int) error {
+	fake.method20Mutex.RLock()
+	defer fake.method20Mutex.RUnlock()
+	return fake.method20ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method20Calls(stub func(int) error) {
+	fake.method20Mutex.Lock()
+	defer fake.method20Mutex.Unlock()
+	fake.Method20Stub = stub
+}
+
+func (fake *FakeWide) Method20Returns(result1 error) {
+	fake.method20Mutex.Lock()
+	defer fake.method20Mutex.Unlock()
+	fake.Method20Stub = nil
+	fake.method20Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method20ReturnsOnCall(i int, result1 error) {
+	fake.method20Mutex.Lock()
+	defer fake.method20Mutex.Unlock()
+	fake.Method20Stub = nil
+	if fake.method20ReturnsOnCall == nil {
+		fake.method20ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method20ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method21(arg1 int) error {
+	fake.method21Mutex.Lock()
+	stub := fake.Method21Stub
+	callIndex := len(fake.method21ArgsForCall)
+	ret, specificReturn := fake.method21ReturnsOnCall[callIndex]
+	fakeReturns := fake.method21Returns
+	fake.method21ArgsForCall = append(fake.method21ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method21Mutex.Unlock()
+	call := fake.recordInvocation("Method21", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method21Mutex.Lock()
+	if fake.method21ResultsForCall == nil {
+		fake.method21ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method21ResultsForCall[callIndex] = results
+	fake.method21Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method21CallCount() int {
+	fake.method21Mutex.RLock()
+	defer fake.method21Mutex.RUnlock()
+	return len(fake.method21ArgsForCall)
+}
+
+func (fake *FakeWide) Method21ArgsForCall(i int) int {
+	fake.method21Mutex.RLock()
+	defer fake.method21Mutex.RUnlock()
+	return fake.method21ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method21ResultsForCall(i int) error {
+	fake.method21Mutex.RLock()
+	defer fake.method21Mutex.RUnlock()
+	return fake.method21ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method21Calls(stub func(int) error) {
+	fake.method21Mutex.Lock()
+	defer fake.method21Mutex.Unlock()
+	fake.Method21Stub = stub
+}
+
+func (fake *FakeWide) Method21Returns(result1 error) {
+	fake.method21Mutex.Lock()
+	defer fake.method21Mutex.Unlock()
+	fake.Method21Stub = nil
+	fake.method21Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method21ReturnsOnCall(i int, result1 error) {
+	fake.method21Mutex.Lock()
+	defer fake.method21Mutex.Unlock()
+	fake.Method21Stub = nil
+	if fake.method21ReturnsOnCall == nil {
+		fake.method21ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method21ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method22(arg1 int) error {
+	fake.method22Mutex.Lock()
+	stub := fake.Method22Stub
+	callIndex := len(fake.method22ArgsForCall)
+	ret, specificReturn := fake.method22ReturnsOnCall[callIndex]
+	fakeReturns := fake.method22Returns
+	fake.method22ArgsForCall = append(fake.method22ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method22Mutex.Unlock()
+	call := fake.recordInvocation("Method22", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method22Mutex.Lock()
+	if fake.method22ResultsForCall == nil {
+		fake.method22ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method22ResultsForCall[callIndex] = results
+	fake.method22Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method22CallCount() int {
+	fake.method22Mutex.RLock()
+	defer fake.method22Mutex.RUnlock()
+	return len(fake.method22ArgsForCall)
+}
+
+func (fake *FakeWide) Method22ArgsForCall(i int) int {
+	fake.method22Mutex.RLock()
+	defer fake.method22Mutex.RUnlock()
+	return fake.method22ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method22ResultsForCall(i int) error {
+	fake.method22Mutex.RLock()
+	defer fake.method22Mutex.RUnlock()
+	return fake.method22ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method22Calls(stub func(int) error) {
+	fake.method22Mutex.Lock()
+	defer fake.method22Mutex.Unlock()
+	fake.Method22Stub = stub
+}
+
+func (fake *FakeWide) Method22Returns(result1 error) {
+	fake.method22Mutex.Lock()
+	defer fake.method22Mutex.Unlock()
+	fake.Method22Stub = nil
+	fake.method22Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method22ReturnsOnCall(i int, result1 error) {
+	fake.method22Mutex.Lock()
+	defer fake.method22Mutex.Unlock()
+	fake.Method22Stub = nil
+	if fake.method22ReturnsOnCall == nil {
+		fake.method22ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method22ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method23(arg1 int) error {
+	fake.method23Mutex.Lock()
+	stub := fake.Method23Stub
+	callIndex := len(fake.method23ArgsForCall)
+	ret, specificReturn := fake.method23ReturnsOnCall[callIndex]
+	fakeReturns := fake.method23Returns
+	fake.method23ArgsForCall = append(fake.method23ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method23Mutex.Unlock()
+	call := fake.recordInvocation("Method23", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method23Mutex.Lock()
+	if fake.method23ResultsForCall == nil {
+		fake.method23ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method23ResultsForCall[callIndex] = results
+	fake.method23Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method23CallCount() int {
+	fake.method23Mutex.RLock()
+	defer fake.method23Mutex.RUnlock()
+	return len(fake.method23ArgsForCall)
+}
+
+func (fake *FakeWide) Method23ArgsForCall(i int) int {
+	fake.method23Mutex.RLock()
+	defer fake.method23Mutex.RUnlock()
+	return fake.method23ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method23ResultsForCall(i int) error {
+	fake.method23Mutex.RLock()
+	defer fake.method23Mutex.RUnlock()
+	return fake.method23ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method23Calls(stub func(int) error) {
+	fake.method23Mutex.Lock()
+	defer fake.method23Mutex.Unlock()
+	fake.Method23Stub = stub
+}
+
+func (fake *FakeWide) Method23Returns(result1 error) {
+	fake.method23Mutex.Lock()
+	defer fake.method23Mutex.Unlock()
+	fake.Method23Stub = nil
+	fake.method23Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method23ReturnsOnCall(i int, result1 error) {
+	fake.method23Mutex.Lock()
+	defer fake.method23Mutex.Unlock()
+	fake.Method23Stub = nil
+	if fake.method23ReturnsOnCall == nil {
+		fake.method23ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method23ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method24(arg1 int) error {
+	fake.method24Mutex.Lock()
+	stub := fake.Method24Stub
+	callIndex := len(fake.method24ArgsForCall)
+	ret, specificReturn := fake.method24ReturnsOnCall[callIndex]
+	fakeReturns := fake.method24Returns
+	fake.method24ArgsForCall = append(fake.method24ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method24Mutex.Unlock()
+	call := fake.recordInvocation("Method24", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method24Mutex.Lock()
+	if fake.method24ResultsForCall == nil {
+		fake.method24ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method24ResultsForCall[callIndex] = results
+	fake.method24Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method24CallCount() int {
+	fake.method24Mutex.RLock()
+	defer fake.method24Mutex.RUnlock()
+	return len(fake.method24ArgsForCall)
+}
+
+func (fake *FakeWide) Method24ArgsForCall(i int) int {
+	fake.method24Mutex.RLock()
+	defer fake.method24Mutex.RUnlock()
+	return fake.method24ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method24ResultsForCall(i int) error {
+	fake.method24Mutex.RLock()
+	defer fake.method24Mutex.RUnlock()
+	return fake.method24ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method24Calls(stub func(int) error) {
+	fake.method24Mutex.Lock()
+	defer fake.method24Mutex.Unlock()
+	fake.Method24Stub = stub
+}
+
+func (fake *FakeWide) Method24Returns(result1 error) {
+	fake.method24Mutex.Lock()
+	defer fake.method24Mutex.Unlock()
+	fake.Method24Stub = nil
+	fake.method24Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method24ReturnsOnCall(i int, result1 error) {
+	fake.method24Mutex.Lock()
+	defer fake.method24Mutex.Unlock()
+	fake.Method24Stub = nil
+	if fake.method24ReturnsOnCall == nil {
+		fake.method24ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method24ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method25(arg1 int) error {
+	fake.method25Mutex.Lock()
+	stub := fake.Method25Stub
+	callIndex := len(fake.method25ArgsForCall)
+	ret, specificReturn := fake.method25ReturnsOnCall[callIndex]
+	fakeReturns := fake.method25Returns
+	fake.method25ArgsForCall = append(fake.method25ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method25Mutex.Unlock()
+	call := fake.recordInvocation("Method25", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method25Mutex.Lock()
+	if fake.method25ResultsForCall == nil {
+		fake.method25ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method25ResultsForCall[callIndex] = results
+	fake.method25Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method25CallCount() int {
+	fake.method25Mutex.RLock()
+	defer fake.method25Mutex.RUnlock()
+	return len(fake.method25ArgsForCall)
+}
+
+func (fake *FakeWide) Method25ArgsForCall(i int) int {
+	fake.method25Mutex.RLock()
+	defer fake.method25Mutex.RUnlock()
+	return fake.method25ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method25ResultsForCall(i int) error {
+	fake.method25Mutex.RLock()
+	defer fake.method25Mutex.RUnlock()
+	return fake.method25ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method25Calls(stub func(int) error) {
+	fake.method25Mutex.Lock()
+	defer fake.method25Mutex.Unlock()
+	fake.Method25Stub = stub
+}
+
+func (fake *FakeWide) Method25Returns(result1 error) {
+	fake.method25Mutex.Lock()
+	defer fake.method25Mutex.Unlock()
+	fake.Method25Stub = nil
+	fake.method25Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method25ReturnsOnCall(i int, result1 error) {
+	fake.method25Mutex.Lock()
+	defer fake.method25Mutex.Unlock()
+	fake.Method25Stub = nil
+	if fake.method25ReturnsOnCall == nil {
+		fake.method25ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method25ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method26(arg1 int) error {
+	fake.method26Mutex.Lock()
+	stub := fake.Method26Stub
+	callIndex := len(fake.method26ArgsForCall)
+	ret, specificReturn := fake.method26ReturnsOnCall[callIndex]
+	fakeReturns := fake.method26Returns
+	fake.method26ArgsForCall = append(fake.method26ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method26Mutex.Unlock()
+	call := fake.recordInvocation("Method26", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method26Mutex.Lock()
+	if fake.method26ResultsForCall == nil {
+		fake.method26ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method26ResultsForCall[callIndex] = results
+	fake.method26Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method26CallCount() int {
+	fake.method26Mutex.RLock()
+	defer fake.method26Mutex.RUnlock()
+	return len(fake.method26ArgsForCall)
+}
+
+func (fake *FakeWide) Method26ArgsForCall(i int) int {
+	fake.method26Mutex.RLock()
+	defer fake.method26Mutex.RUnlock()
+	return fake.method26ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method26ResultsForCall(i int) error {
+	fake.method26Mutex.RLock()
+	defer fake.method26Mutex.RUnlock()
+	return fake.method26ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method26Calls(stub func(int) error) {
+	fake.method26Mutex.Lock()
+	defer fake.method26Mutex.Unlock()
+	fake.Method26Stub = stub
+}
+
+func (fake *FakeWide) Method26Returns(result1 error) {
+	fake.method26Mutex.Lock()
+	defer fake.method26Mutex.Unlock()
+	fake.Method26Stub = nil
+	fake.method26Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method26ReturnsOnCall(i int, result1 error) {
+	fake.method26Mutex.Lock()
+	defer fake.method26Mutex.Unlock()
+	fake.Method26Stub = nil
+	if fake.method26ReturnsOnCall == nil {
+		fake.method26ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method26ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method27(arg1 int) error {
+	fake.method27Mutex.Lock()
+	stub := fake.Method27Stub
+	callIndex := len(fake.method27ArgsForCall)
+	ret, specificReturn := fake.method27ReturnsOnCall[callIndex]
+	fakeReturns := fake.method27Returns
+	fake.method27ArgsForCall = append(fake.method27ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method27Mutex.Unlock()
+	call := fake.recordInvocation("Method27", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method27Mutex.Lock()
+	if fake.method27ResultsForCall == nil {
+		fake.method27ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method27ResultsForCall[callIndex] = results
+	fake.method27Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method27CallCount() int {
+	fake.method27Mutex.RLock()
+	defer fake.method27Mutex.RUnlock()
+	return len(fake.method27ArgsForCall)
+}
+
+func (fake *FakeWide) Method27ArgsForCall(i int) int {
+	fake.method27Mutex.RLock()
+	defer fake.method27Mutex.RUnlock()
+	return fake.method27ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method27ResultsForCall(i int) error {
+	fake.method27Mutex.RLock()
+	defer fake.method27Mutex.RUnlock()
+	return fake.method27ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method27Calls(stub func(int) error) {
+	fake.method27Mutex.Lock()
+	defer fake.method27Mutex.Unlock()
+	fake.Method27Stub = stub
+}
+
+func (fake *FakeWide) Method27Returns(result1 error) {
+	fake.method27Mutex.Lock()
+	defer fake.method27Mutex.Unlock()
+	fake.Method27Stub = nil
+	fake.method27Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method27ReturnsOnCall(i int, result1 error) {
+	fake.method27Mutex.Lock()
+	defer fake.method27Mutex.Unlock()
+	fake.Method27Stub = nil
+	if fake.method27ReturnsOnCall == nil {
+		fake.method27ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method27ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method28(arg1 int) error {
+	fake.method28Mutex.Lock()
+	stub := fake.Method28Stub
+	callIndex := len(fake.method28ArgsForCall)
+	ret, specificReturn := fake.method28ReturnsOnCall[callIndex]
+	fakeReturns := fake.method28Returns
+	fake.method28ArgsForCall = append(fake.method28ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method28Mutex.Unlock()
+	call := fake.recordInvocation("Method28", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method28Mutex.Lock()
+	if fake.method28ResultsForCall == nil {
+		fake.method28ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method28ResultsForCall[callIndex] = results
+	fake.method28Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method28CallCount() int {
+	fake.method28Mutex.RLock()
+	defer fake.method28Mutex.RUnlock()
+	return len(fake.method28ArgsForCall)
+}
+
+func (fake *FakeWide) Method28ArgsForCall(i int) int {
+	fake.method28Mutex.RLock()
+	defer fake.method28Mutex.RUnlock()
+	return fake.method28ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method28ResultsForCall(i int) error {
+	fake.method28Mutex.RLock()
+	defer fake.method28Mutex.RUnlock()
+	return fake.method28ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method28Calls(stub func(int) error) {
+	fake.method28Mutex.Lock()
+	defer fake.method28Mutex.Unlock()
+	fake.Method28Stub = stub
+}
+
+func (fake *FakeWide) Method28Returns(result1 error) {
+	fake.method28Mutex.Lock()
+	defer fake.method28Mutex.Unlock()
+	fake.Method28Stub = nil
+	fake.method28Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method28ReturnsOnCall(i int, result1 error) {
+	fake.method28Mutex.Lock()
+	defer fake.method28Mutex.Unlock()
+	fake.Method28Stub = nil
+	if fake.method28ReturnsOnCall == nil {
+		fake.method28ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method28ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method29(arg1 int) error {
+	fake.method29Mutex.Lock()
+	stub := fake.Method29Stub
+	callIndex := len(fake.method29ArgsForCall)
+	ret, specificReturn := fake.method29ReturnsOnCall[callIndex]
+	fakeReturns := fake.method29Returns
+	fake.method29ArgsForCall = append(fake.method29ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method29Mutex.Unlock()
+	call := fake.recordInvocation("Method29", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method29Mutex.Lock()
+	if fake.method29ResultsForCall == nil {
+		fake.method29ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method29ResultsForCall[callIndex] = results
+	fake.method29Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method29CallCount() int {
+	fake.method29Mutex.RLock()
+	defer fake.method29Mutex.RUnlock()
+	return len(fake.method29ArgsForCall)
+}
+
+func (fake *FakeWide) Method29ArgsForCall(i int) int {
+	fake.method29Mutex.RLock()
+	defer fake.method29Mutex.RUnlock()
+	return fake.method29ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method29ResultsForCall(i int) error {
+	fake.method29Mutex.RLock()
+	defer fake.method29Mutex.RUnlock()
+	return fake.method29ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method29Calls(stub func(int) error) {
+	fake.method29Mutex.Lock()
+	defer fake.method29Mutex.Unlock()
+	fake.Method29Stub = stub
+}
+
+func (fake *FakeWide) Method29Returns(result1 error) {
+	fake.method29Mutex.Lock()
+	defer fake.method29Mutex.Unlock()
+	fake.Method29Stub = nil
+	fake.method29Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method29ReturnsOnCall(i int, result1 error) {
+	fake.method29Mutex.Lock()
+	defer fake.method29Mutex.Unlock()
+	fake.Method29Stub = nil
+	if fake.method29ReturnsOnCall == nil {
+		fake.method29ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method29ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method30(arg1 int) error {
+	fake.method30Mutex.Lock()
+	stub := fake.Method30Stub
+	callIndex := len(fake.method30ArgsForCall)
+	ret, specificReturn := fake.method30ReturnsOnCall[callIndex]
+	fakeReturns := fake.method30Returns
+	fake.method30ArgsForCall = append(fake.method30ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method30Mutex.Unlock()
+	call := fake.recordInvocation("Method30", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method30Mutex.Lock()
+	if fake.method30ResultsForCall == nil {
+		fake.method30ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method30ResultsForCall[callIndex] = results
+	fake.method30Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method30CallCount() int {
+	fake.method30Mutex.RLock()
+	defer fake.method30Mutex.RUnlock()
+	return len(fake.method30ArgsForCall)
+}
+
+func (fake *FakeWide) Method30ArgsForCall(i int) int {
+	fake.method30Mutex.RLock()
+	defer fake.method30Mutex.RUnlock()
+	return fake.method30ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method30ResultsForCall(i int) error {
+	fake.method30Mutex.RLock()
+	defer fake.method30Mutex.RUnlock()
+	return fake.method30ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method30Calls(stub func(int) error) {
+	fake.method30Mutex.Lock()
+	defer fake.method30Mutex.Unlock()
+	fake.Method30Stub = stub
+}
+
+func (fake *FakeWide) Method30Returns(result1 error) {
+	fake.method30Mutex.Lock()
+	defer fake.method30Mutex.Unlock()
+	fake.Method30Stub = nil
+	fake.method30Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method30ReturnsOnCall(i int, result1 error) {
+	fake.method30Mutex.Lock()
+	defer fake.method30Mutex.Unlock()
+	fake.Method30Stub = nil
+	if fake.method30ReturnsOnCall == nil {
+		fake.method30ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method30ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method31(arg1 int) error {
+	fake.method31Mutex.Lock()
+	stub := fake.Method31Stub
+	callIndex := len(fake.method31ArgsForCall)
+	ret, specificReturn := fake.method31ReturnsOnCall[callIndex]
+	fakeReturns := fake.method31Returns
+	fake.method31ArgsForCall = append(fake.method31ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method31Mutex.Unlock()
+	call := fake.recordInvocation("Method31", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method31Mutex.Lock()
+	if fake.method31ResultsForCall == nil {
+		fake.method31ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method31ResultsForCall[callIndex] = results
+	fake.method31Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method31CallCount() int {
+	fake.method31Mutex.RLock()
+	defer fake.method31Mutex.RUnlock()
+	return len(fake.method31ArgsForCall)
+}
+
+func (fake *FakeWide) Method31ArgsForCall(i int) int {
+	fake.method31Mutex.RLock()
+	defer fake.method31Mutex.RUnlock()
+	return fake.method31ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method31ResultsForCall(i int) error {
+	fake.method31Mutex.RLock()
+	defer fake.method31Mutex.RUnlock()
+	return fake.method31ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method31Calls(stub func(int) error) {
+	fake.method31Mutex.Lock()
+	defer fake.method31Mutex.Unlock()
+	fake.Method31Stub = stub
+}
+
+func (fake *FakeWide) Method31Returns(result1 error) {
+	fake.method31Mutex.Lock()
+	defer fake.method31Mutex.Unlock()
+	fake.Method31Stub = nil
+	fake.method31Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method31ReturnsOnCall(i int, result1 error) {
+	fake.method31Mutex.Lock()
+	defer fake.method31Mutex.Unlock()
+	fake.Method31Stub = nil
+	if fake.method31ReturnsOnCall == nil {
+		fake.method31ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method31ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method32(arg1 int) error {
+	fake.method32Mutex.Lock()
+	stub := fake.Method32Stub
+	callIndex := len(fake.method32ArgsForCall)
+	ret, specificReturn := fake.method32ReturnsOnCall[callIndex]
+	fakeReturns := fake.method32Returns
+	fake.method32ArgsForCall = append(fake.method32ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method32Mutex.Unlock()
+	call := fake.recordInvocation("Method32", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method32Mutex.Lock()
+	if fake.method32ResultsForCall == nil {
+		fake.method32ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method32ResultsForCall[callIndex] = results
+	fake.method32Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method32CallCount() int {
+	fake.method32Mutex.RLock()
+	defer fake.method32Mutex.RUnlock()
+	return len(fake.method32ArgsForCall)
+}
+
+func (fake *FakeWide) Method32ArgsForCall(i int) int {
+	fake.method32Mutex.RLock()
+	defer fake.method32Mutex.RUnlock()
+	return fake.method32ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method32ResultsForCall(i int) error {
+	fake.method32Mutex.RLock()
+	defer fake.method32Mutex.RUnlock()
+	return fake.method32ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method32Calls(stub func(int) error) {
+	fake.method32Mutex.Lock()
+	defer fake.method32Mutex.Unlock()
+	fake.Method32Stub = stub
+}
+
+func (fake *FakeWide) Method32Returns(result1 error) {
+	fake.method32Mutex.Lock()
+	defer fake.method32Mutex.Unlock()
+	fake.Method32Stub = nil
+	fake.method32Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method32ReturnsOnCall(i int, result1 error) {
+	fake.method32Mutex.Lock()
+	defer fake.method32Mutex.Unlock()
+	fake.Method32Stub = nil
+	if fake.method32ReturnsOnCall == nil {
+		fake.method32ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method32ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method33(arg1 int) error {
+	fake.method33Mutex.Lock()
+	stub := fake.Method33Stub
+	callIndex := len(fake.method33ArgsForCall)
+	ret, specificReturn := fake.method33ReturnsOnCall[callIndex]
+	fakeReturns := fake.method33Returns
+	fake.method33ArgsForCall = append(fake.method33ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method33Mutex.Unlock()
+	call := fake.recordInvocation("Method33", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method33Mutex.Lock()
+	if fake.method33ResultsForCall == nil {
+		fake.method33ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method33ResultsForCall[callIndex] = results
+	fake.method33Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method33CallCount() int {
+	fake.method33Mutex.RLock()
+	defer fake.method33Mutex.RUnlock()
+	return len(fake.method33ArgsForCall)
+}
+
+func (fake *FakeWide) Method33ArgsForCall(i int) int {
+	fake.method33Mutex.RLock()
+	defer fake.method33Mutex.RUnlock()
+	return fake.method33ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method33ResultsForCall(i int) error {
+	fake.method33Mutex.RLock()
+	defer fake.method33Mutex.RUnlock()
+	return fake.method33ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method33Calls(stub func(int) error) {
+	fake.method33Mutex.Lock()
+	defer fake.method33Mutex.Unlock()
+	fake.Method33Stub = stub
+}
+
+func (fake *FakeWide) Method33Returns(result1 error) {
+	fake.method33Mutex.Lock()
+	defer fake.method33Mutex.Unlock()
+	fake.Method33Stub = nil
+	fake.method33Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method33ReturnsOnCall(i int, result1 error) {
+	fake.method33Mutex.Lock()
+	defer fake.method33Mutex.Unlock()
+	fake.Method33Stub = nil
+	if fake.method33ReturnsOnCall == nil {
+		fake.method33ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method33ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method34(arg1 int) error {
+	fake.method34Mutex.Lock()
+	stub := fake.Method34Stub
+	callIndex := len(fake.method34ArgsForCall)
+	ret, specificReturn := fake.method34ReturnsOnCall[callIndex]
+	fakeReturns := fake.method34Returns
+	fake.method34ArgsForCall = append(fake.method34ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method34Mutex.Unlock()
+	call := fake.recordInvocation("Method34", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method34Mutex.Lock()
+	if fake.method34ResultsForCall == nil {
+		fake.method34ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method34ResultsForCall[callIndex] = results
+	fake.method34Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method34CallCount() int {
+	fake.method34Mutex.RLock()
+	defer fake.method34Mutex.RUnlock()
+	return len(fake.method34ArgsForCall)
+}
+
+func (fake *FakeWide) Method34ArgsForCall(i int) int {
+	fake.method34Mutex.RLock()
+	defer fake.method34Mutex.RUnlock()
+	return fake.method34ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method34ResultsForCall(i int) error {
+	fake.method34Mutex.RLock()
+	defer fake.method34Mutex.RUnlock()
+	return fake.method34ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method34Calls(stub func(int) error) {
+	fake.method34Mutex.Lock()
+	defer fake.method34Mutex.Unlock()
+	fake.Method34Stub = stub
+}
+
+func (fake *FakeWide) Method34Returns(result1 error) {
+	fake.method34Mutex.Lock()
+	defer fake.method34Mutex.Unlock()
+	fake.Method34Stub = nil
+	fake.method34Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method34ReturnsOnCall(i int, result1 error) {
+	fake.method34Mutex.Lock()
+	defer fake.method34Mutex.Unlock()
+	fake.Method34Stub = nil
+	if fake.method34ReturnsOnCall == nil {
+		fake.method34ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method34ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method35(arg1 int) error {
+	fake.method35Mutex.Lock()
+	stub := fake.Method35Stub
+	callIndex := len(fake.method35ArgsForCall)
+	ret, specificReturn := fake.method35ReturnsOnCall[callIndex]
+	fakeReturns := fake.method35Returns
+	fake.method35ArgsForCall = append(fake.method35ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method35Mutex.Unlock()
+	call := fake.recordInvocation("Method35", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method35Mutex.Lock()
+	if fake.method35ResultsForCall == nil {
+		fake.method35ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method35ResultsForCall[callIndex] = results
+	fake.method35Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method35CallCount() int {
+	fake.method35Mutex.RLock()
+	defer fake.method35Mutex.RUnlock()
+	return len(fake.method35ArgsForCall)
+}
+
+func (fake *FakeWide) Method35ArgsForCall(i int) int {
+	fake.method35Mutex.RLock()
+	defer fake.method35Mutex.RUnlock()
+	return fake.method35ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method35ResultsForCall(i int) error {
+	fake.method35Mutex.RLock()
+	defer fake.method35Mutex.RUnlock()
+	return fake.method35ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method35Calls(stub func(int) error) {
+	fake.method35Mutex.Lock()
+	defer fake.method35Mutex.Unlock()
+	fake.Method35Stub = stub
+}
+
+func (fake *FakeWide) Method35Returns(result1 error) {
+	fake.method35Mutex.Lock()
+	defer fake.method35Mutex.Unlock()
+	fake.Method35Stub = nil
+	fake.method35Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method35ReturnsOnCall(i int, result1 error) {
+	fake.method35Mutex.Lock()
+	defer fake.method35Mutex.Unlock()
+	fake.Method35Stub = nil
+	if fake.method35ReturnsOnCall == nil {
+		fake.method35ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method35ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method36(arg1 int) error {
+	fake.method36Mutex.Lock()
+	stub := fake.Method36Stub
+	callIndex := len(fake.method36ArgsForCall)
+	ret, specificReturn := fake.method36ReturnsOnCall[callIndex]
+	fakeReturns := fake.method36Returns
+	fake.method36ArgsForCall = append(fake.method36ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method36Mutex.Unlock()
+	call := fake.recordInvocation("Method36", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method36Mutex.Lock()
+	if fake.method36ResultsForCall == nil {
+		fake.method36ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method36ResultsForCall[callIndex] = results
+	fake.method36Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method36CallCount() int {
+	fake.method36Mutex.RLock()
+	defer fake.method36Mutex.RUnlock()
+	return len(fake.method36ArgsForCall)
+}
+
+func (fake *FakeWide) Method36ArgsForCall(i int) int {
+	fake.method36Mutex.RLock()
+	defer fake.method36Mutex.RUnlock()
+	return fake.method36ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method36ResultsForCall(i int) error {
+	fake.method36Mutex.RLock()
+	defer fake.method36Mutex.RUnlock()
+	return fake.method36ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method36Calls(stub func(int) error) {
+	fake.method36Mutex.Lock()
+	defer fake.method36Mutex.Unlock()
+	fake.Method36Stub = stub
+}
+
+func (fake *FakeWide) Method36Returns(result1 error) {
+	fake.method36Mutex.Lock()
+	defer fake.method36Mutex.Unlock()
+	fake.Method36Stub = nil
+	fake.method36Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method36ReturnsOnCall(i int, result1 error) {
+	fake.method36Mutex.Lock()
+	defer fake.method36Mutex.Unlock()
+	fake.Method36Stub = nil
+	if fake.method36ReturnsOnCall == nil {
+		fake.method36ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method36ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method37(arg1 int) error {
+	fake.method37Mutex.Lock()
+	stub := fake.Method37Stub
+	callIndex := len(fake.method37ArgsForCall)
+	ret, specificReturn := fake.method37ReturnsOnCall[callIndex]
+	fakeReturns := fake.method37Returns
+	fake.method37ArgsForCall = append(fake.method37ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method37Mutex.Unlock()
+	call := fake.recordInvocation("Method37", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method37Mutex.Lock()
+	if fake.method37ResultsForCall == nil {
+		fake.method37ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method37ResultsForCall[callIndex] = results
+	fake.method37Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method37CallCount() int {
+	fake.method37Mutex.RLock()
+	defer fake.method37Mutex.RUnlock()
+	return len(fake.method37ArgsForCall)
+}
+
+func (fake *FakeWide) Method37ArgsForCall(i int) int {
+	fake.method37Mutex.RLock()
+	defer fake.method37Mutex.RUnlock()
+	return fake.method37ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method37ResultsForCall(i int) error {
+	fake.method37Mutex.RLock()
+	defer fake.method37Mutex.RUnlock()
+	return fake.method37ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method37Calls(stub func(int) error) {
+	fake.method37Mutex.Lock()
+	defer fake.method37Mutex.Unlock()
+	fake.Method37Stub = stub
+}
+
+func (fake *FakeWide) Method37Returns(result1 error) {
+	fake.method37Mutex.Lock()
+	defer fake.method37Mutex.Unlock()
+	fake.Method37Stub = nil
+	fake.method37Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method37ReturnsOnCall(i int, result1 error) {
+	fake.method37Mutex.Lock()
+	defer fake.method37Mutex.Unlock()
+	fake.Method37Stub = nil
+	if fake.method37ReturnsOnCall == nil {
+		fake.method37ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method37ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method38(arg1 int) error {
+	fake.method38Mutex.Lock()
+	stub := fake.Method38Stub
+	callIndex := len(fake.method38ArgsForCall)
+	ret, specificReturn := fake.method38ReturnsOnCall[callIndex]
+	fakeReturns := fake.method38Returns
+	fake.method38ArgsForCall = append(fake.method38ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method38Mutex.Unlock()
+	call := fake.recordInvocation("Method38", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method38Mutex.Lock()
+	if fake.method38ResultsForCall == nil {
+		fake.method38ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method38ResultsForCall[callIndex] = results
+	fake.method38Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method38CallCount() int {
+	fake.method38Mutex.RLock()
+	defer fake.method38Mutex.RUnlock()
+	return len(fake.method38ArgsForCall)
+}
+
+func (fake *FakeWide) Method38ArgsForCall(i int) int {
+	fake.method38Mutex.RLock()
+	defer fake.method38Mutex.RUnlock()
+	return fake.method38ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method38ResultsForCall(i int) error {
+	fake.method38Mutex.RLock()
+	defer fake.method38Mutex.RUnlock()
+	return fake.method38ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method38Calls(stub func(int) error) {
+	fake.method38Mutex.Lock()
+	defer fake.method38Mutex.Unlock()
+	fake.Method38Stub = stub
+}
+
+func (fake *FakeWide) Method38Returns(result1 error) {
+	fake.method38Mutex.Lock()
+	defer fake.method38Mutex.Unlock()
+	fake.Method38Stub = nil
+	fake.method38Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method38ReturnsOnCall(i int, result1 error) {
+	fake.method38Mutex.Lock()
+	defer fake.method38Mutex.Unlock()
+	fake.Method38Stub = nil
+	if fake.method38ReturnsOnCall == nil {
+		fake.method38ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method38ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method39(arg1 int) error {
+	fake.method39Mutex.Lock()
+	stub := fake.Method39Stub
+	callIndex := len(fake.method39ArgsForCall)
+	ret, specificReturn := fake.method39ReturnsOnCall[callIndex]
+	fakeReturns := fake.method39Returns
+	fake.method39ArgsForCall = append(fake.method39ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method39Mutex.Unlock()
+	call := fake.recordInvocation("Method39", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method39Mutex.Lock()
+	if fake.method39ResultsForCall == nil {
+		fake.method39ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method39ResultsForCall[callIndex] = results
+	fake.method39Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method39CallCount() int {
+	fake.method39Mutex.RLock()
+	defer fake.method39Mutex.RUnlock()
+	return len(fake.method39ArgsForCall)
+}
+
+func (fake *FakeWide) Method39ArgsForCall(i int) int {
+	fake.method39Mutex.RLock()
+	defer fake.method39Mutex.RUnlock()
+	return fake.method39ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method39ResultsForCall(i int) error {
+	fake.method39Mutex.RLock()
+	defer fake.method39Mutex.RUnlock()
+	return fake.method39ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method39Calls(stub func(int) error) {
+	fake.method39Mutex.Lock()
+	defer fake.method39Mutex.Unlock()
+	fake.Method39Stub = stub
+}
+
+func (fake *FakeWide) Method39Returns(result1 error) {
+	fake.method39Mutex.Lock()
+	defer fake.method39Mutex.Unlock()
+	fake.Method39Stub = nil
+	fake.method39Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method39ReturnsOnCall(i int, result1 error) {
+	fake.method39Mutex.Lock()
+	defer fake.method39Mutex.Unlock()
+	fake.Method39Stub = nil
+	if fake.method39ReturnsOnCall == nil {
+		fake.method39ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method39ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method40(arg1 int) error {
+	fake.method40Mutex.Lock()
+	stub := fake.Method40Stub
+	callIndex := len(fake.method40ArgsForCall)
+	ret, specificReturn := fake.method40ReturnsOnCall[callIndex]
+	fakeReturns := fake.method40Returns
+	fake.method40ArgsForCall = append(fake.method40ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method40Mutex.Unlock()
+	call := fake.recordInvocation("Method40", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method40Mutex.Lock()
+	if fake.method40ResultsForCall == nil {
+		fake.method40ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method40ResultsForCall[callIndex] = results
+	fake.method40Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method40CallCount() int {
+	fake.method40Mutex.RLock()
+	defer fake.method40Mutex.RUnlock()
+	return len(fake.method40ArgsForCall)
+}
+
+func (fake *FakeWide) Method40ArgsForCall(i int) int {
+	fake.method40Mutex.RLock()
+	defer fake.method40Mutex.RUnlock()
+	return fake.method40ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method40ResultsForCall(i int) error {
+	fake.method40Mutex.RLock()
+	defer fake.method40Mutex.RUnlock()
+	return fake.method40ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method40Calls(stub func(int) error) {
+	fake.method40Mutex.Lock()
+	defer fake.method40Mutex.Unlock()
+	fake.Method40Stub = stub
+}
+
+func (fake *FakeWide) Method40Returns(result1 error) {
+	fake.method40Mutex.Lock()
+	defer fake.method40Mutex.Unlock()
+	fake.Method40Stub = nil
+	fake.method40Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method40ReturnsOnCall(i int, result1 error) {
+	fake.method40Mutex.Lock()
+	defer fake.method40Mutex.Unlock()
+	fake.Method40Stub = nil
+	if fake.method40ReturnsOnCall == nil {
+		fake.method40ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method40ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method41(arg1 int) error {
+	fake.method41Mutex.Lock()
+	stub := fake.Method41Stub
+	callIndex := len(fake.method41ArgsForCall)
+	ret, specificReturn := fake.method41ReturnsOnCall[callIndex]
+	fakeReturns := fake.method41Returns
+	fake.method41ArgsForCall = append(fake.method41ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method41Mutex.Unlock()
+	call := fake.recordInvocation("Method41", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method41Mutex.Lock()
+	if fake.method41ResultsForCall == nil {
+		fake.method41ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method41ResultsForCall[callIndex] = results
+	fake.method41Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method41CallCount() int {
+	fake.method41Mutex.RLock()
+	defer fake.method41Mutex.RUnlock()
+	return len(fake.method41ArgsForCall)
+}
+
+func (fake *FakeWide) Method41ArgsForCall(i int) int {
+	fake.method41Mutex.RLock()
+	defer fake.method41Mutex.RUnlock()
+	return fake.method41ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method41ResultsForCall(i int) error {
+	fake.method41Mutex.RLock()
+	defer fake.method41Mutex.RUnlock()
+	return fake.method41ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method41Calls(stub func(int) error) {
+	fake.method41Mutex.Lock()
+	defer fake.method41Mutex.Unlock()
+	fake.Method41Stub = stub
+}
+
+func (fake *FakeWide) Method41Returns(result1 error) {
+	fake.method41Mutex.Lock()
+	defer fake.method41Mutex.Unlock()
+	fake.Method41Stub = nil
+	fake.method41Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method41ReturnsOnCall(i int, result1 error) {
+	fake.method41Mutex.Lock()
+	defer fake.method41Mutex.Unlock()
+	fake.Method41Stub = nil
+	if fake.method41ReturnsOnCall == nil {
+		fake.method41ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method41ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method42(arg1 int) error {
+	fake.method42Mutex.Lock()
+	stub := fake.Method42Stub
+	callIndex := len(fake.method42ArgsForCall)
+	ret, specificReturn := fake.method42ReturnsOnCall[callIndex]
+	fakeReturns := fake.method42Returns
+	fake.method42ArgsForCall = append(fake.method42ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method42Mutex.Unlock()
+	call := fake.recordInvocation("Method42", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method42Mutex.Lock()
+	if fake.method42ResultsForCall == nil {
+		fake.method42ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method42ResultsForCall[callIndex] = results
+	fake.method42Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method42CallCount() int {
+	fake.method42Mutex.RLock()
+	defer fake.method42Mutex.RUnlock()
+	return len(fake.method42ArgsForCall)
+}
+
+func (fake *FakeWide) Method42ArgsForCall(i int) int {
+	fake.method42Mutex.RLock()
+	defer fake.method42Mutex.RUnlock()
+	return fake.method42ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method42ResultsForCall(i int) error {
+	fake.method42Mutex.RLock()
+	defer fake.method42Mutex.RUnlock()
+	return fake.method42ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method42Calls(stub func(int) error) {
+	fake.method42Mutex.Lock()
+	defer fake.method42Mutex.Unlock()
+	fake.Method42Stub = stub
+}
+
+func (fake *FakeWide) Method42Returns(result1 error) {
+	fake.method42Mutex.Lock()
+	defer fake.method42Mutex.Unlock()
+	fake.Method42Stub = nil
+	fake.method42Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method42ReturnsOnCall(i int, result1 error) {
+	fake.method42Mutex.Lock()
+	defer fake.method42Mutex.Unlock()
+	fake.Method42Stub = nil
+	if fake.method42ReturnsOnCall == nil {
+		fake.method42ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method42ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method43(arg1 int) error {
+	fake.method43Mutex.Lock()
+	stub := fake.Method43Stub
+	callIndex := len(fake.method43ArgsForCall)
+	ret, specificReturn := fake.method43ReturnsOnCall[callIndex]
+	fakeReturns := fake.method43Returns
+	fake.method43ArgsForCall = append(fake.method43ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method43Mutex.Unlock()
+	call := fake.recordInvocation("Method43", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method43Mutex.Lock()
+	if fake.method43ResultsForCall == nil {
+		fake.method43ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method43ResultsForCall[callIndex] = results
+	fake.method43Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method43CallCount() int {
+	fake.method43Mutex.RLock()
+	defer fake.method43Mutex.RUnlock()
+	return len(fake.method43ArgsForCall)
+}
+
+func (fake *FakeWide) Method43ArgsForCall(i int) int {
+	fake.method43Mutex.RLock()
+	defer fake.method43Mutex.RUnlock()
+	return fake.method43ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method43ResultsForCall(i int) error {
+	fake.method43Mutex.RLock()
+	defer fake.method43Mutex.RUnlock()
+	return fake.method43ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method43Calls(stub func(int) error) {
+	fake.method43Mutex.Lock()
+	defer fake.method43Mutex.Unlock()
+	fake.Method43Stub = stub
+}
+
+func (fake *FakeWide) Method43Returns(result1 error) {
+	fake.method43Mutex.Lock()
+	defer fake.method43Mutex.Unlock()
+	fake.Method43Stub = nil
+	fake.method43Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method43ReturnsOnCall(i int, result1 error) {
+	fake.method43Mutex.Lock()
+	defer fake.method43Mutex.Unlock()
+	fake.Method43Stub = nil
+	if fake.method43ReturnsOnCall == nil {
+		fake.method43ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method43ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method44(arg1 int) error {
+	fake.method44Mutex.Lock()
+	stub := fake.Method44Stub
+	callIndex := len(fake.method44ArgsForCall)
+	ret, specificReturn := fake.method44ReturnsOnCall[callIndex]
+	fakeReturns := fake.method44Returns
+	fake.method44ArgsForCall = append(fake.method44ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method44Mutex.Unlock()
+	call := fake.recordInvocation("Method44", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method44Mutex.Lock()
+	if fake.method44ResultsForCall == nil {
+		fake.method44ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method44ResultsForCall[callIndex] = results
+	fake.method44Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method44CallCount() int {
+	fake.method44Mutex.RLock()
+	defer fake.method44Mutex.RUnlock()
+	return len(fake.method44ArgsForCall)
+}
+
+func (fake *FakeWide) Method44ArgsForCall(i int) int {
+	fake.method44Mutex.RLock()
+	defer fake.method44Mutex.RUnlock()
+	return fake.method44ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method44ResultsForCall(i int) error {
+	fake.method44Mutex.RLock()
+	defer fake.method44Mutex.RUnlock()
+	return fake.method44ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method44Calls(stub func(int) error) {
+	fake.method44Mutex.Lock()
+	defer fake.method44Mutex.Unlock()
+	fake.Method44Stub = stub
+}
+
+func (fake *FakeWide) Method44Returns(result1 error) {
+	fake.method44Mutex.Lock()
+	defer fake.method44Mutex.Unlock()
+	fake.Method44Stub = nil
+	fake.method44Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method44ReturnsOnCall(i int, result1 error) {
+	fake.method44Mutex.Lock()
+	defer fake.method44Mutex.Unlock()
+	fake.Method44Stub = nil
+	if fake.method44ReturnsOnCall == nil {
+		fake.method44ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method44ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method45(arg1 int) error {
+	fake.method45Mutex.Lock()
+	stub := fake.Method45Stub
+	callIndex := len(fake.method45ArgsForCall)
+	ret, specificReturn := fake.method45ReturnsOnCall[callIndex]
+	fakeReturns := fake.method45Returns
+	fake.method45ArgsForCall = append(fake.method45ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method45Mutex.Unlock()
+	call := fake.recordInvocation("Method45", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method45Mutex.Lock()
+	if fake.method45ResultsForCall == nil {
+		fake.method45ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method45ResultsForCall[callIndex] = results
+	fake.method45Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method45CallCount() int {
+	fake.method45Mutex.RLock()
+	defer fake.method45Mutex.RUnlock()
+	return len(fake.method45ArgsForCall)
+}
+
+func (fake *FakeWide) Method45ArgsForCall(i int) int {
+	fake.method45Mutex.RLock()
+	defer fake.method45Mutex.RUnlock()
+	return fake.method45ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method45ResultsForCall(i int) error {
+	fake.method45Mutex.RLock()
+	defer fake.method45Mutex.RUnlock()
+	return fake.method45ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method45Calls(stub func(int) error) {
+	fake.method45Mutex.Lock()
+	defer fake.method45Mutex.Unlock()
+	fake.Method45Stub = stub
+}
+
+func (fake *FakeWide) Method45Returns(result1 error) {
+	fake.method45Mutex.Lock()
+	defer fake.method45Mutex.Unlock()
+	fake.Method45Stub = nil
+	fake.method45Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method45ReturnsOnCall(i int, result1 error) {
+	fake.method45Mutex.Lock()
+	defer fake.method45Mutex.Unlock()
+	fake.Method45Stub = nil
+	if fake.method45ReturnsOnCall == nil {
+		fake.method45ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method45ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method46(arg1 int) error {
+	fake.method46Mutex.Lock()
+	stub := fake.Method46Stub
+	callIndex := len(fake.method46ArgsForCall)
+	ret, specificReturn := fake.method46ReturnsOnCall[callIndex]
+	fakeReturns := fake.method46Returns
+	fake.method46ArgsForCall = append(fake.method46ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method46Mutex.Unlock()
+	call := fake.recordInvocation("Method46", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method46Mutex.Lock()
+	if fake.method46ResultsForCall == nil {
+		fake.method46ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method46ResultsForCall[callIndex] = results
+	fake.method46Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method46CallCount() int {
+	fake.method46Mutex.RLock()
+	defer fake.method46Mutex.RUnlock()
+	return len(fake.method46ArgsForCall)
+}
+
+func (fake *FakeWide) Method46ArgsForCall(i int) int {
+	fake.method46Mutex.RLock()
+	defer fake.method46Mutex.RUnlock()
+	return fake.method46ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method46ResultsForCall(i int) error {
+	fake.method46Mutex.RLock()
+	defer fake.method46Mutex.RUnlock()
+	return fake.method46ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method46Calls(stub func(int) error) {
+	fake.method46Mutex.Lock()
+	defer fake.method46Mutex.Unlock()
+	fake.Method46Stub = stub
+}
+
+func (fake *FakeWide) Method46Returns(result1 error) {
+	fake.method46Mutex.Lock()
+	defer fake.method46Mutex.Unlock()
+	fake.Method46Stub = nil
+	fake.method46Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method46ReturnsOnCall(i int, result1 error) {
+	fake.method46Mutex.Lock()
+	defer fake.method46Mutex.Unlock()
+	fake.Method46Stub = nil
+	if fake.method46ReturnsOnCall == nil {
+		fake.method46ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method46ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method47(arg1 int) error {
+	fake.method47Mutex.Lock()
+	stub := fake.Method47Stub
+	callIndex := len(fake.method47ArgsForCall)
+	ret, specificReturn := fake.method47ReturnsOnCall[callIndex]
+	fakeReturns := fake.method47Returns
+	fake.method47ArgsForCall = append(fake.method47ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method47Mutex.Unlock()
+	call := fake.recordInvocation("Method47", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method47Mutex.Lock()
+	if fake.method47ResultsForCall == nil {
+		fake.method47ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method47ResultsForCall[callIndex] = results
+	fake.method47Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method47CallCount() int {
+	fake.method47Mutex.RLock()
+	defer fake.method47Mutex.RUnlock()
+	return len(fake.method47ArgsForCall)
+}
+
+func (fake *FakeWide) Method47ArgsForCall(i int) int {
+	fake.method47Mutex.RLock()
+	defer fake.method47Mutex.RUnlock()
+	return fake.method47ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method47ResultsForCall(i int) error {
+	fake.method47Mutex.RLock()
+	defer fake.method47Mutex.RUnlock()
+	return fake.method47ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method47Calls(stub func(int) error) {
+	fake.method47Mutex.Lock()
+	defer fake.method47Mutex.Unlock()
+	fake.Method47Stub = stub
+}
+
+func (fake *FakeWide) Method47Returns(result1 error) {
+	fake.method47Mutex.Lock()
+	defer fake.method47Mutex.Unlock()
+	fake.Method47Stub = nil
+	fake.method47Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method47ReturnsOnCall(i int, result1 error) {
+	fake.method47Mutex.Lock()
+	defer fake.method47Mutex.Unlock()
+	fake.Method47Stub = nil
+	if fake.method47ReturnsOnCall == nil {
+		fake.method47ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method47ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method48(arg1 int) error {
+	fake.method48Mutex.Lock()
+	stub := fake.Method48Stub
+	callIndex := len(fake.method48ArgsForCall)
+	ret, specificReturn := fake.method48ReturnsOnCall[callIndex]
+	fakeReturns := fake.method48Returns
+	fake.method48ArgsForCall = append(fake.method48ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method48Mutex.Unlock()
+	call := fake.recordInvocation("Method48", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method48Mutex.Lock()
+	if fake.method48ResultsForCall == nil {
+		fake.method48ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method48ResultsForCall[callIndex] = results
+	fake.method48Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method48CallCount() int {
+	fake.method48Mutex.RLock()
+	defer fake.method48Mutex.RUnlock()
+	return len(fake.method48ArgsForCall)
+}
+
+func (fake *FakeWide) Method48ArgsForCall(i int) int {
+	fake.method48Mutex.RLock()
+	defer fake.method48Mutex.RUnlock()
+	return fake.method48ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method48ResultsForCall(i int) error {
+	fake.method48Mutex.RLock()
+	defer fake.method48Mutex.RUnlock()
+	return fake.method48ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method48Calls(stub func(int) error) {
+	fake.method48Mutex.Lock()
+	defer fake.method48Mutex.Unlock()
+	fake.Method48Stub = stub
+}
+
+func (fake *FakeWide) Method48Returns(result1 error) {
+	fake.method48Mutex.Lock()
+	defer fake.method48Mutex.Unlock()
+	fake.Method48Stub = nil
+	fake.method48Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method48ReturnsOnCall(i int, result1 error) {
+	fake.method48Mutex.Lock()
+	defer fake.method48Mutex.Unlock()
+	fake.Method48Stub = nil
+	if fake.method48ReturnsOnCall == nil {
+		fake.method48ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method48ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method49(arg1 int) error {
+	fake.method49Mutex.Lock()
+	stub := fake.Method49Stub
+	callIndex := len(fake.method49ArgsForCall)
+	ret, specificReturn := fake.method49ReturnsOnCall[callIndex]
+	fakeReturns := fake.method49Returns
+	fake.method49ArgsForCall = append(fake.method49ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method49Mutex.Unlock()
+	call := fake.recordInvocation("Method49", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method49Mutex.Lock()
+	if fake.method49ResultsForCall == nil {
+		fake.method49ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method49ResultsForCall[callIndex] = results
+	fake.method49Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method49CallCount() int {
+	fake.method49Mutex.RLock()
+	defer fake.method49Mutex.RUnlock()
+	return len(fake.method49ArgsForCall)
+}
+
+func (fake *FakeWide) Method49ArgsForCall(i int) int {
+	fake.method49Mutex.RLock()
+	defer fake.method49Mutex.RUnlock()
+	return fake.method49ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method49ResultsForCall(i int) error {
+	fake.method49Mutex.RLock()
+	defer fake.method49Mutex.RUnlock()
+	return fake.method49ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method49Calls(stub func(int) error) {
+	fake.method49Mutex.Lock()
+	defer fake.method49Mutex.Unlock()
+	fake.Method49Stub = stub
+}
+
+func (fake *FakeWide) Method49Returns(result1 error) {
+	fake.method49Mutex.Lock()
+	defer fake.method49Mutex.Unlock()
+	fake.Method49Stub = nil
+	fake.method49Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method49ReturnsOnCall(i int, result1 error) {
+	fake.method49Mutex.Lock()
+	defer fake.method49Mutex.Unlock()
+	fake.Method49Stub = nil
+	if fake.method49ReturnsOnCall == nil {
+		fake.method49ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method49ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method50(arg1 int) error {
+	fake.method50Mutex.Lock()
+	stub := fake.Method50Stub
+	callIndex := len(fake.method50ArgsForCall)
+	ret, specificReturn := fake.method50ReturnsOnCall[callIndex]
+	fakeReturns := fake.method50Returns
+	fake.method50ArgsForCall = append(fake.method50ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method50Mutex.Unlock()
+	call := fake.recordInvocation("Method50", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method50Mutex.Lock()
+	if fake.method50ResultsForCall == nil {
+		fake.method50ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method50ResultsForCall[callIndex] = results
+	fake.method50Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method50CallCount() int {
+	fake.method50Mutex.RLock()
+	defer fake.method50Mutex.RUnlock()
+	return len(fake.method50ArgsForCall)
+}
+
+func (fake *FakeWide) Method50ArgsForCall(i int) int {
+	fake.method50Mutex.RLock()
+	defer fake.method50Mutex.RUnlock()
+	return fake.method50ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method50ResultsForCall(i int) error {
+	fake.method50Mutex.RLock()
+	defer fake.method50Mutex.RUnlock()
+	return fake.method50ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method50Calls(stub func(int) error) {
+	fake.method50Mutex.Lock()
+	defer fake.method50Mutex.Unlock()
+	fake.Method50Stub = stub
+}
+
+func (fake *FakeWide) Method50Returns(result1 error) {
+	fake.method50Mutex.Lock()
+	defer fake.method50Mutex.Unlock()
+	fake.Method50Stub = nil
+	fake.method50Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method50ReturnsOnCall(i int, result1 error) {
+	fake.method50Mutex.Lock()
+	defer fake.method50Mutex.Unlock()
+	fake.Method50Stub = nil
+	if fake.method50ReturnsOnCall == nil {
+		fake.method50ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method50ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method51(arg1 int) error {
+	fake.method51Mutex.Lock()
+	stub := fake.Method51Stub
+	callIndex := len(fake.method51ArgsForCall)
+	ret, specificReturn := fake.method51ReturnsOnCall[callIndex]
+	fakeReturns := fake.method51Returns
+	fake.method51ArgsForCall = append(fake.method51ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method51Mutex.Unlock()
+	call := fake.recordInvocation("Method51", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method51Mutex.Lock()
+	if fake.method51ResultsForCall == nil {
+		fake.method51ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method51ResultsForCall[callIndex] = results
+	fake.method51Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method51CallCount() int {
+	fake.method51Mutex.RLock()
+	defer fake.method51Mutex.RUnlock()
+	return len(fake.method51ArgsForCall)
+}
+
+func (fake *FakeWide) Method51ArgsForCall(i int) int {
+	fake.method51Mutex.RLock()
+	defer fake.method51Mutex.RUnlock()
+	return fake.method51ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method51ResultsForCall(i int) error {
+	fake.method51Mutex.RLock()
+	defer fake.method51Mutex.RUnlock()
+	return fake.method51ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method51Calls(stub func(int) error) {
+	fake.method51Mutex.Lock()
+	defer fake.method51Mutex.Unlock()
+	fake.Method51Stub = stub
+}
+
+func (fake *FakeWide) Method51Returns(result1 error) {
+	fake.method51Mutex.Lock()
+	defer fake.method51Mutex.Unlock()
+	fake.Method51Stub = nil
+	fake.method51Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method51ReturnsOnCall(i int, result1 error) {
+	fake.method51Mutex.Lock()
+	defer fake.method51Mutex.Unlock()
+	fake.Method51Stub = nil
+	if fake.method51ReturnsOnCall == nil {
+		fake.method51ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method51ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method52(arg1 int) error {
+	fake.method52Mutex.Lock()
+	stub := fake.Method52Stub
+	callIndex := len(fake.method52ArgsForCall)
+	ret, specificReturn := fake.method52ReturnsOnCall[callIndex]
+	fakeReturns := fake.method52Returns
+	fake.method52ArgsForCall = append(fake.method52ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method52Mutex.Unlock()
+	call := fake.recordInvocation("Method52", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method52Mutex.Lock()
+	if fake.method52ResultsForCall == nil {
+		fake.method52ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method52ResultsForCall[callIndex] = results
+	fake.method52Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method52CallCount() int {
+	fake.method52Mutex.RLock()
+	defer fake.method52Mutex.RUnlock()
+	return len(fake.method52ArgsForCall)
+}
+
+func (fake *FakeWide) Method52ArgsForCall(i int) int {
+	fake.method52Mutex.RLock()
+	defer fake.method52Mutex.RUnlock()
+	return fake.method52ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method52ResultsForCall(i int) error {
+	fake.method52Mutex.RLock()
+	defer fake.method52Mutex.RUnlock()
+	return fake.method52ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method52Calls(stub func(int) error) {
+	fake.method52Mutex.Lock()
+	defer fake.method52Mutex.Unlock()
+	fake.Method52Stub = stub
+}
+
+func (fake *FakeWide) Method52Returns(result1 error) {
+	fake.method52Mutex.Lock()
+	defer fake.method52Mutex.Unlock()
+	fake.Method52Stub = nil
+	fake.method52Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method52ReturnsOnCall(i int, result1 error) {
+	fake.method52Mutex.Lock()
+	defer fake.method52Mutex.Unlock()
+	fake.Method52Stub = nil
+	if fake.method52ReturnsOnCall == nil {
+		fake.method52ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method52ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method53(arg1 int) error {
+	fake.method53Mutex.Lock()
+	stub := fake.Method53Stub
+	callIndex := len(fake.method53ArgsForCall)
+	ret, specificReturn := fake.method53ReturnsOnCall[callIndex]
+	fakeReturns := fake.method53Returns
+	fake.method53ArgsForCall = append(fake.method53ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method53Mutex.Unlock()
+	call := fake.recordInvocation("Method53", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method53Mutex.Lock()
+	if fake.method53ResultsForCall == nil {
+		fake.method53ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method53ResultsForCall[callIndex] = results
+	fake.method53Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method53CallCount() int {
+	fake.method53Mutex.RLock()
+	defer fake.method53Mutex.RUnlock()
+	return len(fake.method53ArgsForCall)
+}
+
+func (fake *FakeWide) Method53ArgsForCall(i int) int {
+	fake.method53Mutex.RLock()
+	defer fake.method53Mutex.RUnlock()
+	return fake.method53ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method53ResultsForCall(i int) error {
+	fake.method53Mutex.RLock()
+	defer fake.method53Mutex.RUnlock()
+	return fake.method53ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method53Calls(stub func(int) error) {
+	fake.method53Mutex.Lock()
+	defer fake.method53Mutex.Unlock()
+	fake.Method53Stub = stub
+}
+
+func (fake *FakeWide) Method53Returns(result1 error) {
+	fake.method53Mutex.Lock()
+	defer fake.method53Mutex.Unlock()
+	fake.Method53Stub = nil
+	fake.method53Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method53ReturnsOnCall(i int, result1 error) {
+	fake.method53Mutex.Lock()
+	defer fake.method53Mutex.Unlock()
+	fake.Method53Stub = nil
+	if fake.method53ReturnsOnCall == nil {
+		fake.method53ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method53ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method54(arg1 int) error {
+	fake.method54Mutex.Lock()
+	stub := fake.Method54Stub
+	callIndex := len(fake.method54ArgsForCall)
+	ret, specificReturn := fake.method54ReturnsOnCall[callIndex]
+	fakeReturns := fake.method54Returns
+	fake.method54ArgsForCall = append(fake.method54ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method54Mutex.Unlock()
+	call := fake.recordInvocation("Method54", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method54Mutex.Lock()
+	if fake.method54ResultsForCall == nil {
+		fake.method54ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method54ResultsForCall[callIndex] = results
+	fake.method54Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method54CallCount() int {
+	fake.method54Mutex.RLock()
+	defer fake.method54Mutex.RUnlock()
+	return len(fake.method54ArgsForCall)
+}
+
+func (fake *FakeWide) Method54ArgsForCall(i int) int {
+	fake.method54Mutex.RLock()
+	defer fake.method54Mutex.RUnlock()
+	return fake.method54ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method54ResultsForCall(i int) error {
+	fake.method54Mutex.RLock()
+	defer fake.method54Mutex.RUnlock()
+	return fake.method54ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method54Calls(stub func(int) error) {
+	fake.method54Mutex.Lock()
+	defer fake.method54Mutex.Unlock()
+	fake.Method54Stub = stub
+}
+
+func (fake *FakeWide) Method54Returns(result1 error) {
+	fake.method54Mutex.Lock()
+	defer fake.method54Mutex.Unlock()
+	fake.Method54Stub = nil
+	fake.method54Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method54ReturnsOnCall(i int, result1 error) {
+	fake.method54Mutex.Lock()
+	defer fake.method54Mutex.Unlock()
+	fake.Method54Stub = nil
+	if fake.method54ReturnsOnCall == nil {
+		fake.method54ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method54ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method55(arg1 int) error {
+	fake.method55Mutex.Lock()
+	stub := fake.Method55Stub
+	callIndex := len(fake.method55ArgsForCall)
+	ret, specificReturn := fake.method55ReturnsOnCall[callIndex]
+	fakeReturns := fake.method55Returns
+	fake.method55ArgsForCall = append(fake.method55ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method55Mutex.Unlock()
+	call := fake.recordInvocation("Method55", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method55Mutex.Lock()
+	if fake.method55ResultsForCall == nil {
+		fake.method55ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method55ResultsForCall[callIndex] = results
+	fake.method55Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method55CallCount() int {
+	fake.method55Mutex.RLock()
+	defer fake.method55Mutex.RUnlock()
+	return len(fake.method55ArgsForCall)
+}
+
+func (fake *FakeWide) Method55ArgsForCall(i int) int {
+	fake.method55Mutex.RLock()
+	defer fake.method55Mutex.RUnlock()
+	return fake.method55ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method55ResultsForCall(i int) error {
+	fake.method55Mutex.RLock()
+	defer fake.method55Mutex.RUnlock()
+	return fake.method55ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method55Calls(stub func(int) error) {
+	fake.method55Mutex.Lock()
+	defer fake.method55Mutex.Unlock()
+	fake.Method55Stub = stub
+}
+
+func (fake *FakeWide) Method55Returns(result1 error) {
+	fake.method55Mutex.Lock()
+	defer fake.method55Mutex.Unlock()
+	fake.Method55Stub = nil
+	fake.method55Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method55ReturnsOnCall(i int, result1 error) {
+	fake.method55Mutex.Lock()
+	defer fake.method55Mutex.Unlock()
+	fake.Method55Stub = nil
+	if fake.method55ReturnsOnCall == nil {
+		fake.method55ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method55ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method56(arg1 int) error {
+	fake.method56Mutex.Lock()
+	stub := fake.Method56Stub
+	callIndex := len(fake.method56ArgsForCall)
+	ret, specificReturn := fake.method56ReturnsOnCall[callIndex]
+	fakeReturns := fake.method56Returns
+	fake.method56ArgsForCall = append(fake.method56ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method56Mutex.Unlock()
+	call := fake.recordInvocation("Method56", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method56Mutex.Lock()
+	if fake.method56ResultsForCall == nil {
+		fake.method56ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method56ResultsForCall[callIndex] = results
+	fake.method56Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method56CallCount() int {
+	fake.method56Mutex.RLock()
+	defer fake.method56Mutex.RUnlock()
+	return len(fake.method56ArgsForCall)
+}
+
+func (fake *FakeWide) Method56ArgsForCall(i int) int {
+	fake.method56Mutex.RLock()
+	defer fake.method56Mutex.RUnlock()
+	return fake.method56ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method56ResultsForCall(i int) error {
+	fake.method56Mutex.RLock()
+	defer fake.method56Mutex.RUnlock()
+	return fake.method56ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method56Calls(stub func(int) error) {
+	fake.method56Mutex.Lock()
+	defer fake.method56Mutex.Unlock()
+	fake.Method56Stub = stub
+}
+
+func (fake *FakeWide) Method56Returns(result1 error) {
+	fake.method56Mutex.Lock()
+	defer fake.method56Mutex.Unlock()
+	fake.Method56Stub = nil
+	fake.method56Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method56ReturnsOnCall(i int, result1 error) {
+	fake.method56Mutex.Lock()
+	defer fake.method56Mutex.Unlock()
+	fake.Method56Stub = nil
+	if fake.method56ReturnsOnCall == nil {
+		fake.method56ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method56ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method57(arg1 int) error {
+	fake.method57Mutex.Lock()
+	stub := fake.Method57Stub
+	callIndex := len(fake.method57ArgsForCall)
+	ret, specificReturn := fake.method57ReturnsOnCall[callIndex]
+	fakeReturns := fake.method57Returns
+	fake.method57ArgsForCall = append(fake.method57ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method57Mutex.Unlock()
+	call := fake.recordInvocation("Method57", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method57Mutex.Lock()
+	if fake.method57ResultsForCall == nil {
+		fake.method57ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method57ResultsForCall[callIndex] = results
+	fake.method57Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method57CallCount() int {
+	fake.method57Mutex.RLock()
+	defer fake.method57Mutex.RUnlock()
+	return len(fake.method57ArgsForCall)
+}
+
+func (fake *FakeWide) Method57ArgsForCall(i int) int {
+	fake.method57Mutex.RLock()
+	defer fake.method57Mutex.RUnlock()
+	return fake.method57ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method57ResultsForCall(i int) error {
+	fake.method57Mutex.RLock()
+	defer fake.method57Mutex.RUnlock()
+	return fake.method57ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method57Calls(stub func(int) error) {
+	fake.method57Mutex.Lock()
+	defer fake.method57Mutex.Unlock()
+	fake.Method57Stub = stub
+}
+
+func (fake *FakeWide) Method57Returns(result1 error) {
+	fake.method57Mutex.Lock()
+	defer fake.method57Mutex.Unlock()
+	fake.Method57Stub = nil
+	fake.method57Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method57ReturnsOnCall(i int, result1 error) {
+	fake.method57Mutex.Lock()
+	defer fake.method57Mutex.Unlock()
+	fake.Method57Stub = nil
+	if fake.method57ReturnsOnCall == nil {
+		fake.method57ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method57ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method58(arg1 int) error {
+	fake.method58Mutex.Lock()
+	stub := fake.Method58Stub
+	callIndex := len(fake.method58ArgsForCall)
+	ret, specificReturn := fake.method58ReturnsOnCall[callIndex]
+	fakeReturns := fake.method58Returns
+	fake.method58ArgsForCall = append(fake.method58ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method58Mutex.Unlock()
+	call := fake.recordInvocation("Method58", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method58Mutex.Lock()
+	if fake.method58ResultsForCall == nil {
+		fake.method58ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method58ResultsForCall[callIndex] = results
+	fake.method58Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method58CallCount() int {
+	fake.method58Mutex.RLock()
+	defer fake.method58Mutex.RUnlock()
+	return len(fake.method58ArgsForCall)
+}
+
+func (fake *FakeWide) Method58ArgsForCall(i int) int {
+	fake.method58Mutex.RLock()
+	defer fake.method58Mutex.RUnlock()
+	return fake.method58ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method58ResultsForCall(i int) error {
+	fake.method58Mutex.RLock()
+	defer fake.method58Mutex.RUnlock()
+	return fake.method58ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method58Calls(stub func(int) error) {
+	fake.method58Mutex.Lock()
+	defer fake.method58Mutex.Unlock()
+	fake.Method58Stub = stub
+}
+
+func (fake *FakeWide) Method58Returns(result1 error) {
+	fake.method58Mutex.Lock()
+	defer fake.method58Mutex.Unlock()
+	fake.Method58Stub = nil
+	fake.method58Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method58ReturnsOnCall(i int, result1 error) {
+	fake.method58Mutex.Lock()
+	defer fake.method58Mutex.Unlock()
+	fake.Method58Stub = nil
+	if fake.method58ReturnsOnCall == nil {
+		fake.method58ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method58ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method59(arg1 int) error {
+	fake.method59Mutex.Lock()
+	stub := fake.Method59Stub
+	callIndex := len(fake.method59ArgsForCall)
+	ret, specificReturn := fake.method59ReturnsOnCall[callIndex]
+	fakeReturns := fake.method59Returns
+	fake.method59ArgsForCall = append(fake.method59ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method59Mutex.Unlock()
+	call := fake.recordInvocation("Method59", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method59Mutex.Lock()
+	if fake.method59ResultsForCall == nil {
+		fake.method59ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method59ResultsForCall[callIndex] = results
+	fake.method59Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method59CallCount() int {
+	fake.method59Mutex.RLock()
+	defer fake.method59Mutex.RUnlock()
+	return len(fake.method59ArgsForCall)
+}
+
+func (fake *FakeWide) Method59ArgsForCall(i int) int {
+	fake.method59Mutex.RLock()
+	defer fake.method59Mutex.RUnlock()
+	return fake.method59ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method59ResultsForCall(i int) error {
+	fake.method59Mutex.RLock()
+	defer fake.method59Mutex.RUnlock()
+	return fake.method59ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method59Calls(stub func(int) error) {
+	fake.method59Mutex.Lock()
+	defer fake.method59Mutex.Unlock()
+	fake.Method59Stub = stub
+}
+
+func (fake *FakeWide) Method59Returns(result1 error) {
+	fake.method59Mutex.Lock()
+	defer fake.method59Mutex.Unlock()
+	fake.Method59Stub = nil
+	fake.method59Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method59ReturnsOnCall(i int, result1 error) {
+	fake.method59Mutex.Lock()
+	defer fake.method59Mutex.Unlock()
+	fake.Method59Stub = nil
+	if fake.method59ReturnsOnCall == nil {
+		fake.method59ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method59ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method60(arg1 int) error {
+	fake.method60Mutex.Lock()
+	stub := fake.Method60Stub
+	callIndex := len(fake.method60ArgsForCall)
+	ret, specificReturn := fake.method60ReturnsOnCall[callIndex]
+	fakeReturns := fake.method60Returns
+	fake.method60ArgsForCall = append(fake.method60ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method60Mutex.Unlock()
+	call := fake.recordInvocation("Method60", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method60Mutex.Lock()
+	if fake.method60ResultsForCall == nil {
+		fake.method60ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method60ResultsForCall[callIndex] = results
+	fake.method60Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method60CallCount() int {
+	fake.method60Mutex.RLock()
+	defer fake.method60Mutex.RUnlock()
+	return len(fake.method60ArgsForCall)
+}
+
+func (fake *FakeWide) Method60ArgsForCall(i int) int {
+	fake.method60Mutex.RLock()
+	defer fake.method60Mutex.RUnlock()
+	return fake.method60ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method60ResultsForCall(i int) error {
+	fake.method60Mutex.RLock()
+	defer fake.method60Mutex.RUnlock()
+	return fake.method60ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method60Calls(stub func(int) error) {
+	fake.method60Mutex.Lock()
+	defer fake.method60Mutex.Unlock()
+	fake.Method60Stub = stub
+}
+
+func (fake *FakeWide) Method60Returns(result1 error) {
+	fake.method60Mutex.Lock()
+	defer fake.method60Mutex.Unlock()
+	fake.Method60Stub = nil
+	fake.method60Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method60ReturnsOnCall(i int, result1 error) {
+	fake.method60Mutex.Lock()
+	defer fake.method60Mutex.Unlock()
+	fake.Method60Stub = nil
+	if fake.method60ReturnsOnCall == nil {
+		fake.method60ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method60ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method61(arg1 int) error {
+	fake.method61Mutex.Lock()
+	stub := fake.Method61Stub
+	callIndex := len(fake.method61ArgsForCall)
+	ret, specificReturn := fake.method61ReturnsOnCall[callIndex]
+	fakeReturns := fake.method61Returns
+	fake.method61ArgsForCall = append(fake.method61ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method61Mutex.Unlock()
+	call := fake.recordInvocation("Method61", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method61Mutex.Lock()
+	if fake.method61ResultsForCall == nil {
+		fake.method61ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method61ResultsForCall[callIndex] = results
+	fake.method61Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method61CallCount() int {
+	fake.method61Mutex.RLock()
+	defer fake.method61Mutex.RUnlock()
+	return len(fake.method61ArgsForCall)
+}
+
+func (fake *FakeWide) Method61ArgsForCall(i int) int {
+	fake.method61Mutex.RLock()
+	defer fake.method61Mutex.RUnlock()
+	return fake.method61ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method61ResultsForCall(i int) error {
+	fake.method61Mutex.RLock()
+	defer fake.method61Mutex.RUnlock()
+	return fake.method61ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method61Calls(stub func(int) error) {
+	fake.method61Mutex.Lock()
+	defer fake.method61Mutex.Unlock()
+	fake.Method61Stub = stub
+}
+
+func (fake *FakeWide) Method61Returns(result1 error) {
+	fake.method61Mutex.Lock()
+	defer fake.method61Mutex.Unlock()
+	fake.Method61Stub = nil
+	fake.method61Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method61ReturnsOnCall(i int, result1 error) {
+	fake.method61Mutex.Lock()
+	defer fake.method61Mutex.Unlock()
+	fake.Method61Stub = nil
+	if fake.method61ReturnsOnCall == nil {
+		fake.method61ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method61ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method62(arg1 int) error {
+	fake.method62Mutex.Lock()
+	stub := fake.Method62Stub
+	callIndex := len(fake.method62ArgsForCall)
+	ret, specificReturn := fake.method62ReturnsOnCall[callIndex]
+	fakeReturns := fake.method62Returns
+	fake.method62ArgsForCall = append(fake.method62ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method62Mutex.Unlock()
+	call := fake.recordInvocation("Method62", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method62Mutex.Lock()
+	if fake.method62ResultsForCall == nil {
+		fake.method62ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method62ResultsForCall[callIndex] = results
+	fake.method62Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method62CallCount() int {
+	fake.method62Mutex.RLock()
+	defer fake.method62Mutex.RUnlock()
+	return len(fake.method62ArgsForCall)
+}
+
+func (fake *FakeWide) Method62ArgsForCall(i int) int {
+	fake.method62Mutex.RLock()
+	defer fake.method62Mutex.RUnlock()
+	return fake.method62ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method62ResultsForCall(i int) error {
+	fake.method62Mutex.RLock()
+	defer fake.method62Mutex.RUnlock()
+	return fake.method62ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method62Calls(stub func(int) error) {
+	fake.method62Mutex.Lock()
+	defer fake.method62Mutex.Unlock()
+	fake.Method62Stub = stub
+}
+
+func (fake *FakeWide) Method62Returns(result1 error) {
+	fake.method62Mutex.Lock()
+	defer fake.method62Mutex.Unlock()
+	fake.Method62Stub = nil
+	fake.method62Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method62ReturnsOnCall(i int, result1 error) {
+	fake.method62Mutex.Lock()
+	defer fake.method62Mutex.Unlock()
+	fake.Method62Stub = nil
+	if fake.method62ReturnsOnCall == nil {
+		fake.method62ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method62ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method63(arg1 int) error {
+	fake.method63Mutex.Lock()
+	stub := fake.Method63Stub
+	callIndex := len(fake.method63ArgsForCall)
+	ret, specificReturn := fake.method63ReturnsOnCall[callIndex]
+	fakeReturns := fake.method63Returns
+	fake.method63ArgsForCall = append(fake.method63ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method63Mutex.Unlock()
+	call := fake.recordInvocation("Method63", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method63Mutex.Lock()
+	if fake.method63ResultsForCall == nil {
+		fake.method63ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method63ResultsForCall[callIndex] = results
+	fake.method63Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method63CallCount() int {
+	fake.method63Mutex.RLock()
+	defer fake.method63Mutex.RUnlock()
+	return len(fake.method63ArgsForCall)
+}
+
+func (fake *FakeWide) Method63ArgsForCall(i int) int {
+	fake.method63Mutex.RLock()
+	defer fake.method63Mutex.RUnlock()
+	return fake.method63ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method63ResultsForCall(i int) error {
+	fake.method63Mutex.RLock()
+	defer fake.method63Mutex.RUnlock()
+	return fake.method63ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method63Calls(stub func(int) error) {
+	fake.method63Mutex.Lock()
+	defer fake.method63Mutex.Unlock()
+	fake.Method63Stub = stub
+}
+
+func (fake *FakeWide) Method63Returns(result1 error) {
+	fake.method63Mutex.Lock()
+	defer fake.method63Mutex.Unlock()
+	fake.Method63Stub = nil
+	fake.method63Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method63ReturnsOnCall(i int, result1 error) {
+	fake.method63Mutex.Lock()
+	defer fake.method63Mutex.Unlock()
+	fake.Method63Stub = nil
+	if fake.method63ReturnsOnCall == nil {
+		fake.method63ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method63ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method64(arg1 int) error {
+	fake.method64Mutex.Lock()
+	stub := fake.Method64Stub
+	callIndex := len(fake.method64ArgsForCall)
+	ret, specificReturn := fake.method64ReturnsOnCall[callIndex]
+	fakeReturns := fake.method64Returns
+	fake.method64ArgsForCall = append(fake.method64ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method64Mutex.Unlock()
+	call := fake.recordInvocation("Method64", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method64Mutex.Lock()
+	if fake.method64ResultsForCall == nil {
+		fake.method64ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method64ResultsForCall[callIndex] = results
+	fake.method64Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method64CallCount() int {
+	fake.method64Mutex.RLock()
+	defer fake.method64Mutex.RUnlock()
+	return len(fake.method64ArgsForCall)
+}
+
+func (fake *FakeWide) Method64ArgsForCall(i int) int {
+	fake.method64Mutex.RLock()
+	defer fake.method64Mutex.RUnlock()
+	return fake.method64ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method64ResultsForCall(i int) error {
+	fake.method64Mutex.RLock()
+	defer fake.method64Mutex.RUnlock()
+	return fake.method64ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method64Calls(stub func(int) error) {
+	fake.method64Mutex.Lock()
+	defer fake.method64Mutex.Unlock()
+	fake.Method64Stub = stub
+}
+
+func (fake *FakeWide) Method64Returns(result1 error) {
+	fake.method64Mutex.Lock()
+	defer fake.method64Mutex.Unlock()
+	fake.Method64Stub = nil
+	fake.method64Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method64ReturnsOnCall(i int, result1 error) {
+	fake.method64Mutex.Lock()
+	defer fake.method64Mutex.Unlock()
+	fake.Method64Stub = nil
+	if fake.method64ReturnsOnCall == nil {
+		fake.method64ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method64ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method65(arg1 int) error {
+	fake.method65Mutex.Lock()
+	stub := fake.Method65Stub
+	callIndex := len(fake.method65ArgsForCall)
+	ret, specificReturn := fake.method65ReturnsOnCall[callIndex]
+	fakeReturns := fake.method65Returns
+	fake.method65ArgsForCall = append(fake.method65ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method65Mutex.Unlock()
+	call := fake.recordInvocation("Method65", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method65Mutex.Lock()
+	if fake.method65ResultsForCall == nil {
+		fake.method65ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method65ResultsForCall[callIndex] = results
+	fake.method65Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method65CallCount() int {
+	fake.method65Mutex.RLock()
+	defer fake.method65Mutex.RUnlock()
+	return len(fake.method65ArgsForCall)
+}
+
+func (fake *FakeWide) Method65ArgsForCall(i int) int {
+	fake.method65Mutex.RLock()
+	defer fake.method65Mutex.RUnlock()
+	return fake.method65ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method65ResultsForCall(i int) error {
+	fake.method65Mutex.RLock()
+	defer fake.method65Mutex.RUnlock()
+	return fake.method65ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method65Calls(stub func(int) error) {
+	fake.method65Mutex.Lock()
+	defer fake.method65Mutex.Unlock()
+	fake.Method65Stub = stub
+}
+
+func (fake *FakeWide) Method65Returns(result1 error) {
+	fake.method65Mutex.Lock()
+	defer fake.method65Mutex.Unlock()
+	fake.Method65Stub = nil
+	fake.method65Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method65ReturnsOnCall(i int, result1 error) {
+	fake.method65Mutex.Lock()
+	defer fake.method65Mutex.Unlock()
+	fake.Method65Stub = nil
+	if fake.method65ReturnsOnCall == nil {
+		fake.method65ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method65ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method66(arg1 int) error {
+	fake.method66Mutex.Lock()
+	stub := fake.Method66Stub
+	callIndex := len(fake.method66ArgsForCall)
+	ret, specificReturn := fake.method66ReturnsOnCall[callIndex]
+	fakeReturns := fake.method66Returns
+	fake.method66ArgsForCall = append(fake.method66ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method66Mutex.Unlock()
+	call := fake.recordInvocation("Method66", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method66Mutex.Lock()
+	if fake.method66ResultsForCall == nil {
+		fake.method66ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method66ResultsForCall[callIndex] = results
+	fake.method66Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method66CallCount() int {
+	fake.method66Mutex.RLock()
+	defer fake.method66Mutex.RUnlock()
+	return len(fake.method66ArgsForCall)
+}
+
+func (fake *FakeWide) Method66ArgsForCall(i int) int {
+	fake.method66Mutex.RLock()
+	defer fake.method66Mutex.RUnlock()
+	return fake.method66ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method66ResultsForCall(i int) error {
+	fake.method66Mutex.RLock()
+	defer fake.method66Mutex.RUnlock()
+	return fake.method66ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method66Calls(stub func(int) error) {
+	fake.method66Mutex.Lock()
+	defer fake.method66Mutex.Unlock()
+	fake.Method66Stub = stub
+}
+
+func (fake *FakeWide) Method66Returns(result1 error) {
+	fake.method66Mutex.Lock()
+	defer fake.method66Mutex.Unlock()
+	fake.Method66Stub = nil
+	fake.method66Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method66ReturnsOnCall(i int, result1 error) {
+	fake.method66Mutex.Lock()
+	defer fake.method66Mutex.Unlock()
+	fake.Method66Stub = nil
+	if fake.method66ReturnsOnCall == nil {
+		fake.method66ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method66ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method67(arg1 int) error {
+	fake.method67Mutex.Lock()
+	stub := fake.Method67Stub
+	callIndex := len(fake.method67ArgsForCall)
+	ret, specificReturn := fake.method67ReturnsOnCall[callIndex]
+	fakeReturns := fake.method67Returns
+	fake.method67ArgsForCall = append(fake.method67ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method67Mutex.Unlock()
+	call := fake.recordInvocation("Method67", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method67Mutex.Lock()
+	if fake.method67ResultsForCall == nil {
+		fake.method67ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method67ResultsForCall[callIndex] = results
+	fake.method67Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method67CallCount() int {
+	fake.method67Mutex.RLock()
+	defer fake.method67Mutex.RUnlock()
+	return len(fake.method67ArgsForCall)
+}
+
+func (fake *FakeWide) Method67ArgsForCall(i int) int {
+	fake.method67Mutex.RLock()
+	defer fake.method67Mutex.RUnlock()
+	return fake.method67ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method67ResultsForCall(i int) error {
+	fake.method67Mutex.RLock()
+	defer fake.method67Mutex.RUnlock()
+	return fake.method67ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method67Calls(stub func(int) error) {
+	fake.method67Mutex.Lock()
+	defer fake.method67Mutex.Unlock()
+	fake.Method67Stub = stub
+}
+
+func (fake *FakeWide) Method67Returns(result1 error) {
+	fake.method67Mutex.Lock()
+	defer fake.method67Mutex.Unlock()
+	fake.Method67Stub = nil
+	fake.method67Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method67ReturnsOnCall(i int, result1 error) {
+	fake.method67Mutex.Lock()
+	defer fake.method67Mutex.Unlock()
+	fake.Method67Stub = nil
+	if fake.method67ReturnsOnCall == nil {
+		fake.method67ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method67ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method68(arg1 int) error {
+	fake.method68Mutex.Lock()
+	stub := fake.Method68Stub
+	callIndex := len(fake.method68ArgsForCall)
+	ret, specificReturn := fake.method68ReturnsOnCall[callIndex]
+	fakeReturns := fake.method68Returns
+	fake.method68ArgsForCall = append(fake.method68ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method68Mutex.Unlock()
+	call := fake.recordInvocation("Method68", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method68Mutex.Lock()
+	if fake.method68ResultsForCall == nil {
+		fake.method68ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method68ResultsForCall[callIndex] = results
+	fake.method68Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method68CallCount() int {
+	fake.method68Mutex.RLock()
+	defer fake.method68Mutex.RUnlock()
+	return len(fake.method68ArgsForCall)
+}
+
+func (fake *FakeWide) Method68ArgsForCall(i int) int {
+	fake.method68Mutex.RLock()
+	defer fake.method68Mutex.RUnlock()
+	return fake.method68ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method68ResultsForCall(i int) error {
+	fake.method68Mutex.RLock()
+	defer fake.method68Mutex.RUnlock()
+	return fake.method68ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method68Calls(stub func(int) error) {
+	fake.method68Mutex.Lock()
+	defer fake.method68Mutex.Unlock()
+	fake.Method68Stub = stub
+}
+
+func (fake *FakeWide) Method68Returns(result1 error) {
+	fake.method68Mutex.Lock()
+	defer fake.method68Mutex.Unlock()
+	fake.Method68Stub = nil
+	fake.method68Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method68ReturnsOnCall(i int, result1 error) {
+	fake.method68Mutex.Lock()
+	defer fake.method68Mutex.Unlock()
+	fake.Method68Stub = nil
+	if fake.method68ReturnsOnCall == nil {
+		fake.method68ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method68ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method69(arg1 int) error {
+	fake.method69Mutex.Lock()
+	stub := fake.Method69Stub
+	callIndex := len(fake.method69ArgsForCall)
+	ret, specificReturn := fake.method69ReturnsOnCall[callIndex]
+	fakeReturns := fake.method69Returns
+	fake.method69ArgsForCall = append(fake.method69ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method69Mutex.Unlock()
+	call := fake.recordInvocation("Method69", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method69Mutex.Lock()
+	if fake.method69ResultsForCall == nil {
+		fake.method69ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method69ResultsForCall[callIndex] = results
+	fake.method69Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method69CallCount() int {
+	fake.method69Mutex.RLock()
+	defer fake.method69Mutex.RUnlock()
+	return len(fake.method69ArgsForCall)
+}
+
+func (fake *FakeWide) Method69ArgsForCall(i int) int {
+	fake.method69Mutex.RLock()
+	defer fake.method69Mutex.RUnlock()
+	return fake.method69ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method69ResultsForCall(i int) error {
+	fake.method69Mutex.RLock()
+	defer fake.method69Mutex.RUnlock()
+	return fake.method69ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method69Calls(stub func(int) error) {
+	fake.method69Mutex.Lock()
+	defer fake.method69Mutex.Unlock()
+	fake.Method69Stub = stub
+}
+
+func (fake *FakeWide) Method69Returns(result1 error) {
+	fake.method69Mutex.Lock()
+	defer fake.method69Mutex.Unlock()
+	fake.Method69Stub = nil
+	fake.method69Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method69ReturnsOnCall(i int, result1 error) {
+	fake.method69Mutex.Lock()
+	defer fake.method69Mutex.Unlock()
+	fake.Method69Stub = nil
+	if fake.method69ReturnsOnCall == nil {
+		fake.method69ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method69ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method70(arg1 int) error {
+	fake.method70Mutex.Lock()
+	stub := fake.Method70Stub
+	callIndex := len(fake.method70ArgsForCall)
+	ret, specificReturn := fake.method70ReturnsOnCall[callIndex]
+	fakeReturns := fake.method70Returns
+	fake.method70ArgsForCall = append(fake.method70ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method70Mutex.Unlock()
+	call := fake.recordInvocation("Method70", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method70Mutex.Lock()
+	if fake.method70ResultsForCall == nil {
+		fake.method70ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method70ResultsForCall[callIndex] = results
+	fake.method70Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method70CallCount() int {
+	fake.method70Mutex.RLock()
+	defer fake.method70Mutex.RUnlock()
+	return len(fake.method70ArgsForCall)
+}
+
+func (fake *FakeWide) Method70ArgsForCall(i int) int {
+	fake.method70Mutex.RLock()
+	defer fake.method70Mutex.RUnlock()
+	return fake.method70ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method70ResultsForCall(i int) error {
+	fake.method70Mutex.RLock()
+	defer fake.method70Mutex.RUnlock()
+	return fake.method70ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method70Calls(stub func(int) error) {
+	fake.method70Mutex.Lock()
+	defer fake.method70Mutex.Unlock()
+	fake.Method70Stub = stub
+}
+
+func (fake *FakeWide) Method70Returns(result1 error) {
+	fake.method70Mutex.Lock()
+	defer fake.method70Mutex.Unlock()
+	fake.Method70Stub = nil
+	fake.method70Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method70ReturnsOnCall(i int, result1 error) {
+	fake.method70Mutex.Lock()
+	defer fake.method70Mutex.Unlock()
+	fake.Method70Stub = nil
+	if fake.method70ReturnsOnCall == nil {
+		fake.method70ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method70ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method71(arg1 int) error {
+	fake.method71Mutex.Lock()
+	stub := fake.Method71Stub
+	callIndex := len(fake.method71ArgsForCall)
+	ret, specificReturn := fake.method71ReturnsOnCall[callIndex]
+	fakeReturns := fake.method71Returns
+	fake.method71ArgsForCall = append(fake.method71ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method71Mutex.Unlock()
+	call := fake.recordInvocation("Method71", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method71Mutex.Lock()
+	if fake.method71ResultsForCall == nil {
+		fake.method71ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method71ResultsForCall[callIndex] = results
+	fake.method71Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method71CallCount() int {
+	fake.method71Mutex.RLock()
+	defer fake.method71Mutex.RUnlock()
+	return len(fake.method71ArgsForCall)
+}
+
+func (fake *FakeWide) Method71ArgsForCall(i int) int {
+	fake.method71Mutex.RLock()
+	defer fake.method71Mutex.RUnlock()
+	return fake.method71ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method71ResultsForCall(i int) error {
+	fake.method71Mutex.RLock()
+	defer fake.method71Mutex.RUnlock()
+	return fake.method71ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method71Calls(stub func(int) error) {
+	fake.method71Mutex.Lock()
+	defer fake.method71Mutex.Unlock()
+	fake.Method71Stub = stub
+}
+
+func (fake *FakeWide) Method71Returns(result1 error) {
+	fake.method71Mutex.Lock()
+	defer fake.method71Mutex.Unlock()
+	fake.Method71Stub = nil
+	fake.method71Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method71ReturnsOnCall(i int, result1 error) {
+	fake.method71Mutex.Lock()
+	defer fake.method71Mutex.Unlock()
+	fake.Method71Stub = nil
+	if fake.method71ReturnsOnCall == nil {
+		fake.method71ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method71ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method72(arg1 int) error {
+	fake.method72Mutex.Lock()
+	stub := fake.Method72Stub
+	callIndex := len(fake.method72ArgsForCall)
+	ret, specificReturn := fake.method72ReturnsOnCall[callIndex]
+	fakeReturns := fake.method72Returns
+	fake.method72ArgsForCall = append(fake.method72ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method72Mutex.Unlock()
+	call := fake.recordInvocation("Method72", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method72Mutex.Lock()
+	if fake.method72ResultsForCall == nil {
+		fake.method72ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method72ResultsForCall[callIndex] = results
+	fake.method72Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method72CallCount() int {
+	fake.method72Mutex.RLock()
+	defer fake.method72Mutex.RUnlock()
+	return len(fake.method72ArgsForCall)
+}
+
+func (fake *FakeWide) Method72ArgsForCall(i int) int {
+	fake.method72Mutex.RLock()
+	defer fake.method72Mutex.RUnlock()
+	return fake.method72ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method72ResultsForCall(i int) error {
+	fake.method72Mutex.RLock()
+	defer fake.method72Mutex.RUnlock()
+	return fake.method72ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method72Calls(stub func(int) error) {
+	fake.method72Mutex.Lock()
+	defer fake.method72Mutex.Unlock()
+	fake.Method72Stub = stub
+}
+
+func (fake *FakeWide) Method72Returns(result1 error) {
+	fake.method72Mutex.Lock()
+	defer fake.method72Mutex.Unlock()
+	fake.Method72Stub = nil
+	fake.method72Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method72ReturnsOnCall(i int, result1 error) {
+	fake.method72Mutex.Lock()
+	defer fake.method72Mutex.Unlock()
+	fake.Method72Stub = nil
+	if fake.method72ReturnsOnCall == nil {
+		fake.method72ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method72ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method73(arg1 int) error {
+	fake.method73Mutex.Lock()
+	stub := fake.Method73Stub
+	callIndex := len(fake.method73ArgsForCall)
+	ret, specificReturn := fake.method73ReturnsOnCall[callIndex]
+	fakeReturns := fake.method73Returns
+	fake.method73ArgsForCall = append(fake.method73ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method73Mutex.Unlock()
+	call := fake.recordInvocation("Method73", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method73Mutex.Lock()
+	if fake.method73ResultsForCall == nil {
+		fake.method73ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method73ResultsForCall[callIndex] = results
+	fake.method73Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method73CallCount() int {
+	fake.method73Mutex.RLock()
+	defer fake.method73Mutex.RUnlock()
+	return len(fake.method73ArgsForCall)
+}
+
+func (fake *FakeWide) Method73ArgsForCall(i int) int {
+	fake.method73Mutex.RLock()
+	defer fake.method73Mutex.RUnlock()
+	return fake.method73ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method73ResultsForCall(i int) error {
+	fake.method73Mutex.RLock()
+	defer fake.method73Mutex.RUnlock()
+	return fake.method73ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method73Calls(stub func(int) error) {
+	fake.method73Mutex.Lock()
+	defer fake.method73Mutex.Unlock()
+	fake.Method73Stub = stub
+}
+
+func (fake *FakeWide) Method73Returns(result1 error) {
+	fake.method73Mutex.Lock()
+	defer fake.method73Mutex.Unlock()
+	fake.Method73Stub = nil
+	fake.method73Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method73ReturnsOnCall(i int, result1 error) {
+	fake.method73Mutex.Lock()
+	defer fake.method73Mutex.Unlock()
+	fake.Method73Stub = nil
+	if fake.method73ReturnsOnCall == nil {
+		fake.method73ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method73ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method74(arg1 int) error {
+	fake.method74Mutex.Lock()
+	stub := fake.Method74Stub
+	callIndex := len(fake.method74ArgsForCall)
+	ret, specificReturn := fake.method74ReturnsOnCall[callIndex]
+	fakeReturns := fake.method74Returns
+	fake.method74ArgsForCall = append(fake.method74ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method74Mutex.Unlock()
+	call := fake.recordInvocation("Method74", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method74Mutex.Lock()
+	if fake.method74ResultsForCall == nil {
+		fake.method74ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method74ResultsForCall[callIndex] = results
+	fake.method74Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method74CallCount() int {
+	fake.method74Mutex.RLock()
+	defer fake.method74Mutex.RUnlock()
+	return len(fake.method74ArgsForCall)
+}
+
+func (fake *FakeWide) Method74ArgsForCall(i int) int {
+	fake.method74Mutex.RLock()
+	defer fake.method74Mutex.RUnlock()
+	return fake.method74ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method74ResultsForCall(i int) error {
+	fake.method74Mutex.RLock()
+	defer fake.method74Mutex.RUnlock()
+	return fake.method74ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method74Calls(stub func(int) error) {
+	fake.method74Mutex.Lock()
+	defer fake.method74Mutex.Unlock()
+	fake.Method74Stub = stub
+}
+
+func (fake *FakeWide) Method74Returns(result1 error) {
+	fake.method74Mutex.Lock()
+	defer fake.method74Mutex.Unlock()
+	fake.Method74Stub = nil
+	fake.method74Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method74ReturnsOnCall(i int, result1 error) {
+	fake.method74Mutex.Lock()
+	defer fake.method74Mutex.Unlock()
+	fake.Method74Stub = nil
+	if fake.method74ReturnsOnCall == nil {
+		fake.method74ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method74ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method75(arg1 int) error {
+	fake.method75Mutex.Lock()
+	stub := fake.Method75Stub
+	callIndex := len(fake.method75ArgsForCall)
+	ret, specificReturn := fake.method75ReturnsOnCall[callIndex]
+	fakeReturns := fake.method75Returns
+	fake.method75ArgsForCall = append(fake.method75ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method75Mutex.Unlock()
+	call := fake.recordInvocation("Method75", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method75Mutex.Lock()
+	if fake.method75ResultsForCall == nil {
+		fake.method75ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method75ResultsForCall[callIndex] = results
+	fake.method75Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method75CallCount() int {
+	fake.method75Mutex.RLock()
+	defer fake.method75Mutex.RUnlock()
+	return len(fake.method75ArgsForCall)
+}
+
+func (fake *FakeWide) Method75ArgsForCall(i int) int {
+	fake.method75Mutex.RLock()
+	defer fake.method75Mutex.RUnlock()
+	return fake.method75ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method75ResultsForCall(i int) error {
+	fake.method75Mutex.RLock()
+	defer fake.method75Mutex.RUnlock()
+	return fake.method75ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method75Calls(stub func(int) error) {
+	fake.method75Mutex.Lock()
+	defer fake.method75Mutex.Unlock()
+	fake.Method75Stub = stub
+}
+
+func (fake *FakeWide) Method75Returns(result1 error) {
+	fake.method75Mutex.Lock()
+	defer fake.method75Mutex.Unlock()
+	fake.Method75Stub = nil
+	fake.method75Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method75ReturnsOnCall(i int, result1 error) {
+	fake.method75Mutex.Lock()
+	defer fake.method75Mutex.Unlock()
+	fake.Method75Stub = nil
+	if fake.method75ReturnsOnCall == nil {
+		fake.method75ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method75ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method76(arg1 int) error {
+	fake.method76Mutex.Lock()
+	stub := fake.Method76Stub
+	callIndex := len(fake.method76ArgsForCall)
+	ret, specificReturn := fake.method76ReturnsOnCall[callIndex]
+	fakeReturns := fake.method76Returns
+	fake.method76ArgsForCall = append(fake.method76ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method76Mutex.Unlock()
+	call := fake.recordInvocation("Method76", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method76Mutex.Lock()
+	if fake.method76ResultsForCall == nil {
+		fake.method76ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method76ResultsForCall[callIndex] = results
+	fake.method76Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method76CallCount() int {
+	fake.method76Mutex.RLock()
+	defer fake.method76Mutex.RUnlock()
+	return len(fake.method76ArgsForCall)
+}
+
+func (fake *FakeWide) Method76ArgsForCall(i int) int {
+	fake.method76Mutex.RLock()
+	defer fake.method76Mutex.RUnlock()
+	return fake.method76ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method76ResultsForCall(i int) error {
+	fake.method76Mutex.RLock()
+	defer fake.method76Mutex.RUnlock()
+	return fake.method76ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method76Calls(stub func(int) error) {
+	fake.method76Mutex.Lock()
+	defer fake.method76Mutex.Unlock()
+	fake.Method76Stub = stub
+}
+
+func (fake *FakeWide) Method76Returns(result1 error) {
+	fake.method76Mutex.Lock()
+	defer fake.method76Mutex.Unlock()
+	fake.Method76Stub = nil
+	fake.method76Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method76ReturnsOnCall(i int, result1 error) {
+	fake.method76Mutex.Lock()
+	defer fake.method76Mutex.Unlock()
+	fake.Method76Stub = nil
+	if fake.method76ReturnsOnCall == nil {
+		fake.method76ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method76ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method77(arg1 int) error {
+	fake.method77Mutex.Lock()
+	stub := fake.Method77Stub
+	callIndex := len(fake.method77ArgsForCall)
+	ret, specificReturn := fake.method77ReturnsOnCall[callIndex]
+	fakeReturns := fake.method77Returns
+	fake.method77ArgsForCall = append(fake.method77ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method77Mutex.Unlock()
+	call := fake.recordInvocation("Method77", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method77Mutex.Lock()
+	if fake.method77ResultsForCall == nil {
+		fake.method77ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method77ResultsForCall[callIndex] = results
+	fake.method77Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method77CallCount() int {
+	fake.method77Mutex.RLock()
+	defer fake.method77Mutex.RUnlock()
+	return len(fake.method77ArgsForCall)
+}
+
+func (fake *FakeWide) Method77ArgsForCall(i int) int {
+	fake.method77Mutex.RLock()
+	defer fake.method77Mutex.RUnlock()
+	return fake.method77ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method77ResultsForCall(i int) error {
+	fake.method77Mutex.RLock()
+	defer fake.method77Mutex.RUnlock()
+	return fake.method77ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method77Calls(stub func(int) error) {
+	fake.method77Mutex.Lock()
+	defer fake.method77Mutex.Unlock()
+	fake.Method77Stub = stub
+}
+
+func (fake *FakeWide) Method77Returns(result1 error) {
+	fake.method77Mutex.Lock()
+	defer fake.method77Mutex.Unlock()
+	fake.Method77Stub = nil
+	fake.method77Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method77ReturnsOnCall(i int, result1 error) {
+	fake.method77Mutex.Lock()
+	defer fake.method77Mutex.Unlock()
+	fake.Method77Stub = nil
+	if fake.method77ReturnsOnCall == nil {
+		fake.method77ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method77ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method78(arg1 int) error {
+	fake.method78Mutex.Lock()
+	stub := fake.Method78Stub
+	callIndex := len(fake.method78ArgsForCall)
+	ret, specificReturn := fake.method78ReturnsOnCall[callIndex]
+	fakeReturns := fake.method78Returns
+	fake.method78ArgsForCall = append(fake.method78ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method78Mutex.Unlock()
+	call := fake.recordInvocation("Method78", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method78Mutex.Lock()
+	if fake.method78ResultsForCall == nil {
+		fake.method78ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method78ResultsForCall[callIndex] = results
+	fake.method78Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method78CallCount() int {
+	fake.method78Mutex.RLock()
+	defer fake.method78Mutex.RUnlock()
+	return len(fake.method78ArgsForCall)
+}
+
+func (fake *FakeWide) Method78ArgsForCall(i int) int {
+	fake.method78Mutex.RLock()
+	defer fake.method78Mutex.RUnlock()
+	return fake.method78ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method78ResultsForCall(i int) error {
+	fake.method78Mutex.RLock()
+	defer fake.method78Mutex.RUnlock()
+	return fake.method78ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method78Calls(stub func(int) error) {
+	fake.method78Mutex.Lock()
+	defer fake.method78Mutex.Unlock()
+	fake.Method78Stub = stub
+}
+
+func (fake *FakeWide) Method78Returns(result1 error) {
+	fake.method78Mutex.Lock()
+	defer fake.method78Mutex.Unlock()
+	fake.Method78Stub = nil
+	fake.method78Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method78ReturnsOnCall(i int, result1 error) {
+	fake.method78Mutex.Lock()
+	defer fake.method78Mutex.Unlock()
+	fake.Method78Stub = nil
+	if fake.method78ReturnsOnCall == nil {
+		fake.method78ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method78ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method79(arg1 int) error {
+	fake.method79Mutex.Lock()
+	stub := fake.Method79Stub
+	callIndex := len(fake.method79ArgsForCall)
+	ret, specificReturn := fake.method79ReturnsOnCall[callIndex]
+	fakeReturns := fake.method79Returns
+	fake.method79ArgsForCall = append(fake.method79ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method79Mutex.Unlock()
+	call := fake.recordInvocation("Method79", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method79Mutex.Lock()
+	if fake.method79ResultsForCall == nil {
+		fake.method79ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method79ResultsForCall[callIndex] = results
+	fake.method79Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method79CallCount() int {
+	fake.method79Mutex.RLock()
+	defer fake.method79Mutex.RUnlock()
+	return len(fake.method79ArgsForCall)
+}
+
+func (fake *FakeWide) Method79ArgsForCall(i int) int {
+	fake.method79Mutex.RLock()
+	defer fake.method79Mutex.RUnlock()
+	return fake.method79ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method79ResultsForCall(i int) error {
+	fake.method79Mutex.RLock()
+	defer fake.method79Mutex.RUnlock()
+	return fake.method79ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method79Calls(stub func(int) error) {
+	fake.method79Mutex.Lock()
+	defer fake.method79Mutex.Unlock()
+	fake.Method79Stub = stub
+}
+
+func (fake *FakeWide) Method79Returns(result1 error) {
+	fake.method79Mutex.Lock()
+	defer fake.method79Mutex.Unlock()
+	fake.Method79Stub = nil
+	fake.method79Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method79ReturnsOnCall(i int, result1 error) {
+	fake.method79Mutex.Lock()
+	defer fake.method79Mutex.Unlock()
+	fake.Method79Stub = nil
+	if fake.method79ReturnsOnCall == nil {
+		fake.method79ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method79ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method80(arg1 int) error {
+	fake.method80Mutex.Lock()
+	stub := fake.Method80Stub
+	callIndex := len(fake.method80ArgsForCall)
+	ret, specificReturn := fake.method80ReturnsOnCall[callIndex]
+	fakeReturns := fake.method80Returns
+	fake.method80ArgsForCall = append(fake.method80ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method80Mutex.Unlock()
+	call := fake.recordInvocation("Method80", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method80Mutex.Lock()
+	if fake.method80ResultsForCall == nil {
+		fake.method80ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method80ResultsForCall[callIndex] = results
+	fake.method80Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method80CallCount() int {
+	fake.method80Mutex.RLock()
+	defer fake.method80Mutex.RUnlock()
+	return len(fake.method80ArgsForCall)
+}
+
+func (fake *FakeWide) Method80ArgsForCall(i int) int {
+	fake.method80Mutex.RLock()
+	defer fake.method80Mutex.RUnlock()
+	return fake.method80ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method80ResultsForCall(i int) error {
+	fake.method80Mutex.RLock()
+	defer fake.method80Mutex.RUnlock()
+	return fake.method80ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method80Calls(stub func(int) error) {
+	fake.method80Mutex.Lock()
+	defer fake.method80Mutex.Unlock()
+	fake.Method80Stub = stub
+}
+
+func (fake *FakeWide) Method80Returns(result1 error) {
+	fake.method80Mutex.Lock()
+	defer fake.method80Mutex.Unlock()
+	fake.Method80Stub = nil
+	fake.method80Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method80ReturnsOnCall(i int, result1 error) {
+	fake.method80Mutex.Lock()
+	defer fake.method80Mutex.Unlock()
+	fake.Method80Stub = nil
+	if fake.method80ReturnsOnCall == nil {
+		fake.method80ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method80ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method81(arg1 int) error {
+	fake.method81Mutex.Lock()
+	stub := fake.Method81Stub
+	callIndex := len(fake.method81ArgsForCall)
+	ret, specificReturn := fake.method81ReturnsOnCall[callIndex]
+	fakeReturns := fake.method81Returns
+	fake.method81ArgsForCall = append(fake.method81ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method81Mutex.Unlock()
+	call := fake.recordInvocation("Method81", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method81Mutex.Lock()
+	if fake.method81ResultsForCall == nil {
+		fake.method81ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method81ResultsForCall[callIndex] = results
+	fake.method81Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method81CallCount() int {
+	fake.method81Mutex.RLock()
+	defer fake.method81Mutex.RUnlock()
+	return len(fake.method81ArgsForCall)
+}
+
+func (fake *FakeWide) Method81ArgsForCall(i int) int {
+	fake.method81Mutex.RLock()
+	defer fake.method81Mutex.RUnlock()
+	return fake.method81ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method81ResultsForCall(i int) error {
+	fake.method81Mutex.RLock()
+	defer fake.method81Mutex.RUnlock()
+	return fake.method81ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method81Calls(stub func(int) error) {
+	fake.method81Mutex.Lock()
+	defer fake.method81Mutex.Unlock()
+	fake.Method81Stub = stub
+}
+
+func (fake *FakeWide) Method81Returns(result1 error) {
+	fake.method81Mutex.Lock()
+	defer fake.method81Mutex.Unlock()
+	fake.Method81Stub = nil
+	fake.method81Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method81ReturnsOnCall(i int, result1 error) {
+	fake.method81Mutex.Lock()
+	defer fake.method81Mutex.Unlock()
+	fake.Method81Stub = nil
+	if fake.method81ReturnsOnCall == nil {
+		fake.method81ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method81ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method82(arg1 int) error {
+	fake.method82Mutex.Lock()
+	stub := fake.Method82Stub
+	callIndex := len(fake.method82ArgsForCall)
+	ret, specificReturn := fake.method82ReturnsOnCall[callIndex]
+	fakeReturns := fake.method82Returns
+	fake.method82ArgsForCall = append(fake.method82ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method82Mutex.Unlock()
+	call := fake.recordInvocation("Method82", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method82Mutex.Lock()
+	if fake.method82ResultsForCall == nil {
+		fake.method82ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method82ResultsForCall[callIndex] = results
+	fake.method82Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method82CallCount() int {
+	fake.method82Mutex.RLock()
+	defer fake.method82Mutex.RUnlock()
+	return len(fake.method82ArgsForCall)
+}
+
+func (fake *FakeWide) Method82ArgsForCall(i int) int {
+	fake.method82Mutex.RLock()
+	defer fake.method82Mutex.RUnlock()
+	return fake.method82ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method82ResultsForCall(i int) error {
+	fake.method82Mutex.RLock()
+	defer fake.method82Mutex.RUnlock()
+	return fake.method82ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method82Calls(stub func(int) error) {
+	fake.method82Mutex.Lock()
+	defer fake.method82Mutex.Unlock()
+	fake.Method82Stub = stub
+}
+
+func (fake *FakeWide) Method82Returns(result1 error) {
+	fake.method82Mutex.Lock()
+	defer fake.method82Mutex.Unlock()
+	fake.Method82Stub = nil
+	fake.method82Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method82ReturnsOnCall(i int, result1 error) {
+	fake.method82Mutex.Lock()
+	defer fake.method82Mutex.Unlock()
+	fake.Method82Stub = nil
+	if fake.method82ReturnsOnCall == nil {
+		fake.method82ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method82ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method83(arg1 int) error {
+	fake.method83Mutex.Lock()
+	stub := fake.Method83Stub
+	callIndex := len(fake.method83ArgsForCall)
+	ret, specificReturn := fake.method83ReturnsOnCall[callIndex]
+	fakeReturns := fake.method83Returns
+	fake.method83ArgsForCall = append(fake.method83ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method83Mutex.Unlock()
+	call := fake.recordInvocation("Method83", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method83Mutex.Lock()
+	if fake.method83ResultsForCall == nil {
+		fake.method83ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method83ResultsForCall[callIndex] = results
+	fake.method83Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method83CallCount() int {
+	fake.method83Mutex.RLock()
+	defer fake.method83Mutex.RUnlock()
+	return len(fake.method83ArgsForCall)
+}
+
+func (fake *FakeWide) Method83ArgsForCall(i int) int {
+	fake.method83Mutex.RLock()
+	defer fake.method83Mutex.RUnlock()
+	return fake.method83ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method83ResultsForCall(i int) error {
+	fake.method83Mutex.RLock()
+	defer fake.method83Mutex.RUnlock()
+	return fake.method83ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method83Calls(stub func(int) error) {
+	fake.method83Mutex.Lock()
+	defer fake.method83Mutex.Unlock()
+	fake.Method83Stub = stub
+}
+
+func (fake *FakeWide) Method83Returns(result1 error) {
+	fake.method83Mutex.Lock()
+	defer fake.method83Mutex.Unlock()
+	fake.Method83Stub = nil
+	fake.method83Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method83ReturnsOnCall(i int, result1 error) {
+	fake.method83Mutex.Lock()
+	defer fake.method83Mutex.Unlock()
+	fake.Method83Stub = nil
+	if fake.method83ReturnsOnCall == nil {
+		fake.method83ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method83ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method84(arg1 int) error {
+	fake.method84Mutex.Lock()
+	stub := fake.Method84Stub
+	callIndex := len(fake.method84ArgsForCall)
+	ret, specificReturn := fake.method84ReturnsOnCall[callIndex]
+	fakeReturns := fake.method84Returns
+	fake.method84ArgsForCall = append(fake.method84ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method84Mutex.Unlock()
+	call := fake.recordInvocation("Method84", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method84Mutex.Lock()
+	if fake.method84ResultsForCall == nil {
+		fake.method84ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method84ResultsForCall[callIndex] = results
+	fake.method84Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method84CallCount() int {
+	fake.method84Mutex.RLock()
+	defer fake.method84Mutex.RUnlock()
+	return len(fake.method84ArgsForCall)
+}
+
+func (fake *FakeWide) Method84ArgsForCall(i int) int {
+	fake.method84Mutex.RLock()
+	defer fake.method84Mutex.RUnlock()
+	return fake.method84ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method84ResultsForCall(i int) error {
+	fake.method84Mutex.RLock()
+	defer fake.method84Mutex.RUnlock()
+	return fake.method84ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method84Calls(stub func(int) error) {
+	fake.method84Mutex.Lock()
+	defer fake.method84Mutex.Unlock()
+	fake.Method84Stub = stub
+}
+
+func (fake *FakeWide) Method84Returns(result1 error) {
+	fake.method84Mutex.Lock()
+	defer fake.method84Mutex.Unlock()
+	fake.Method84Stub = nil
+	fake.method84Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method84ReturnsOnCall(i int, result1 error) {
+	fake.method84Mutex.Lock()
+	defer fake.method84Mutex.Unlock()
+	fake.Method84Stub = nil
+	if fake.method84ReturnsOnCall == nil {
+		fake.method84ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method84ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method85(arg1 int) error {
+	fake.method85Mutex.Lock()
+	stub := fake.Method85Stub
+	callIndex := len(fake.method85ArgsForCall)
+	ret, specificReturn := fake.method85ReturnsOnCall[callIndex]
+	fakeReturns := fake.method85Returns
+	fake.method85ArgsForCall = append(fake.method85ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method85Mutex.Unlock()
+	call := fake.recordInvocation("Method85", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method85Mutex.Lock()
+	if fake.method85ResultsForCall == nil {
+		fake.method85ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method85ResultsForCall[callIndex] = results
+	fake.method85Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method85CallCount() int {
+	fake.method85Mutex.RLock()
+	defer fake.method85Mutex.RUnlock()
+	return len(fake.method85ArgsForCall)
+}
+
+func (fake *FakeWide) Method85ArgsForCall(i int) int {
+	fake.method85Mutex.RLock()
+	defer fake.method85Mutex.RUnlock()
+	return fake.method85ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method85ResultsForCall(i int) error {
+	fake.method85Mutex.RLock()
+	defer fake.method85Mutex.RUnlock()
+	return fake.method85ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method85Calls(stub func(int) error) {
+	fake.method85Mutex.Lock()
+	defer fake.method85Mutex.Unlock()
+	fake.Method85Stub = stub
+}
+
+func (fake *FakeWide) Method85Returns(result1 error) {
+	fake.method85Mutex.Lock()
+	defer fake.method85Mutex.Unlock()
+	fake.Method85Stub = nil
+	fake.method85Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method85ReturnsOnCall(i int, result1 error) {
+	fake.method85Mutex.Lock()
+	defer fake.method85Mutex.Unlock()
+	fake.Method85Stub = nil
+	if fake.method85ReturnsOnCall == nil {
+		fake.method85ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method85ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method86(arg1 int) error {
+	fake.method86Mutex.Lock()
+	stub := fake.Method86Stub
+	callIndex := len(fake.method86ArgsForCall)
+	ret, specificReturn := fake.method86ReturnsOnCall[callIndex]
+	fakeReturns := fake.method86Returns
+	fake.method86ArgsForCall = append(fake.method86ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method86Mutex.Unlock()
+	call := fake.recordInvocation("Method86", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method86Mutex.Lock()
+	if fake.method86ResultsForCall == nil {
+		fake.method86ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method86ResultsForCall[callIndex] = results
+	fake.method86Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method86CallCount() int {
+	fake.method86Mutex.RLock()
+	defer fake.method86Mutex.RUnlock()
+	return len(fake.method86ArgsForCall)
+}
+
+func (fake *FakeWide) Method86ArgsForCall(i int) int {
+	fake.method86Mutex.RLock()
+	defer fake.method86Mutex.RUnlock()
+	return fake.method86ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method86ResultsForCall(i int) error {
+	fake.method86Mutex.RLock()
+	defer fake.method86Mutex.RUnlock()
+	return fake.method86ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method86Calls(stub func(int) error) {
+	fake.method86Mutex.Lock()
+	defer fake.method86Mutex.Unlock()
+	fake.Method86Stub = stub
+}
+
+func (fake *FakeWide) Method86Returns(result1 error) {
+	fake.method86Mutex.Lock()
+	defer fake.method86Mutex.Unlock()
+	fake.Method86Stub = nil
+	fake.method86Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method86ReturnsOnCall(i int, result1 error) {
+	fake.method86Mutex.Lock()
+	defer fake.method86Mutex.Unlock()
+	fake.Method86Stub = nil
+	if fake.method86ReturnsOnCall == nil {
+		fake.method86ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method86ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method87(arg1 int) error {
+	fake.method87Mutex.Lock()
+	stub := fake.Method87Stub
+	callIndex := len(fake.method87ArgsForCall)
+	ret, specificReturn := fake.method87ReturnsOnCall[callIndex]
+	fakeReturns := fake.method87Returns
+	fake.method87ArgsForCall = append(fake.method87ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method87Mutex.Unlock()
+	call := fake.recordInvocation("Method87", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method87Mutex.Lock()
+	if fake.method87ResultsForCall == nil {
+		fake.method87ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method87ResultsForCall[callIndex] = results
+	fake.method87Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method87CallCount() int {
+	fake.method87Mutex.RLock()
+	defer fake.method87Mutex.RUnlock()
+	return len(fake.method87ArgsForCall)
+}
+
+func (fake *FakeWide) Method87ArgsForCall(i int) int {
+	fake.method87Mutex.RLock()
+	defer fake.method87Mutex.RUnlock()
+	return fake.method87ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method87ResultsForCall(i int) error {
+	fake.method87Mutex.RLock()
+	defer fake.method87Mutex.RUnlock()
+	return fake.method87ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method87Calls(stub func(int) error) {
+	fake.method87Mutex.Lock()
+	defer fake.method87Mutex.Unlock()
+	fake.Method87Stub = stub
+}
+
+func (fake *FakeWide) Method87Returns(result1 error) {
+	fake.method87Mutex.Lock()
+	defer fake.method87Mutex.Unlock()
+	fake.Method87Stub = nil
+	fake.method87Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method87ReturnsOnCall(i int, result1 error) {
+	fake.method87Mutex.Lock()
+	defer fake.method87Mutex.Unlock()
+	fake.Method87Stub = nil
+	if fake.method87ReturnsOnCall == nil {
+		fake.method87ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method87ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method88(arg1 int) error {
+	fake.method88Mutex.Lock()
+	stub := fake.Method88Stub
+	callIndex := len(fake.method88ArgsForCall)
+	ret, specificReturn := fake.method88ReturnsOnCall[callIndex]
+	fakeReturns := fake.method88Returns
+	fake.method88ArgsForCall = append(fake.method88ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method88Mutex.Unlock()
+	call := fake.recordInvocation("Method88", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method88Mutex.Lock()
+	if fake.method88ResultsForCall == nil {
+		fake.method88ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method88ResultsForCall[callIndex] = results
+	fake.method88Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method88CallCount() int {
+	fake.method88Mutex.RLock()
+	defer fake.method88Mutex.RUnlock()
+	return len(fake.method88ArgsForCall)
+}
+
+func (fake *FakeWide) Method88ArgsForCall(i int) int {
+	fake.method88Mutex.RLock()
+	defer fake.method88Mutex.RUnlock()
+	return fake.method88ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method88ResultsForCall(i int) error {
+	fake.method88Mutex.RLock()
+	defer fake.method88Mutex.RUnlock()
+	return fake.method88ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method88Calls(stub func(int) error) {
+	fake.method88Mutex.Lock()
+	defer fake.method88Mutex.Unlock()
+	fake.Method88Stub = stub
+}
+
+func (fake *FakeWide) Method88Returns(result1 error) {
+	fake.method88Mutex.Lock()
+	defer fake.method88Mutex.Unlock()
+	fake.Method88Stub = nil
+	fake.method88Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method88ReturnsOnCall(i int, result1 error) {
+	fake.method88Mutex.Lock()
+	defer fake.method88Mutex.Unlock()
+	fake.Method88Stub = nil
+	if fake.method88ReturnsOnCall == nil {
+		fake.method88ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method88ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method89(arg1 int) error {
+	fake.method89Mutex.Lock()
+	stub := fake.Method89Stub
+	callIndex := len(fake.method89ArgsForCall)
+	ret, specificReturn := fake.method89ReturnsOnCall[callIndex]
+	fakeReturns := fake.method89Returns
+	fake.method89ArgsForCall = append(fake.method89ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method89Mutex.Unlock()
+	call := fake.recordInvocation("Method89", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method89Mutex.Lock()
+	if fake.method89ResultsForCall == nil {
+		fake.method89ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method89ResultsForCall[callIndex] = results
+	fake.method89Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method89CallCount() int {
+	fake.method89Mutex.RLock()
+	defer fake.method89Mutex.RUnlock()
+	return len(fake.method89ArgsForCall)
+}
+
+func (fake *FakeWide) Method89ArgsForCall(i int) int {
+	fake.method89Mutex.RLock()
+	defer fake.method89Mutex.RUnlock()
+	return fake.method89ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method89ResultsForCall(i int) error {
+	fake.method89Mutex.RLock()
+	defer fake.method89Mutex.RUnlock()
+	return fake.method89ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method89Calls(stub func(int) error) {
+	fake.method89Mutex.Lock()
+	defer fake.method89Mutex.Unlock()
+	fake.Method89Stub = stub
+}
+
+func (fake *FakeWide) Method89Returns(result1 error) {
+	fake.method89Mutex.Lock()
+	defer fake.method89Mutex.Unlock()
+	fake.Method89Stub = nil
+	fake.method89Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method89ReturnsOnCall(i int, result1 error) {
+	fake.method89Mutex.Lock()
+	defer fake.method89Mutex.Unlock()
+	fake.Method89Stub = nil
+	if fake.method89ReturnsOnCall == nil {
+		fake.method89ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method89ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method90(arg1 int) error {
+	fake.method90Mutex.Lock()
+	stub := fake.Method90Stub
+	callIndex := len(fake.method90ArgsForCall)
+	ret, specificReturn := fake.method90ReturnsOnCall[callIndex]
+	fakeReturns := fake.method90Returns
+	fake.method90ArgsForCall = append(fake.method90ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method90Mutex.Unlock()
+	call := fake.recordInvocation("Method90", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method90Mutex.Lock()
+	if fake.method90ResultsForCall == nil {
+		fake.method90ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method90ResultsForCall[callIndex] = results
+	fake.method90Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method90CallCount() int {
+	fake.method90Mutex.RLock()
+	defer fake.method90Mutex.RUnlock()
+	return len(fake.method90ArgsForCall)
+}
+
+func (fake *FakeWide) Method90ArgsForCall(i int) int {
+	fake.method90Mutex.RLock()
+	defer fake.method90Mutex.RUnlock()
+	return fake.method90ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method90ResultsForCall(i int) error {
+	fake.method90Mutex.RLock()
+	defer fake.method90Mutex.RUnlock()
+	return fake.method90ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method90Calls(stub func(int) error) {
+	fake.method90Mutex.Lock()
+	defer fake.method90Mutex.Unlock()
+	fake.Method90Stub = stub
+}
+
+func (fake *FakeWide) Method90Returns(result1 error) {
+	fake.method90Mutex.Lock()
+	defer fake.method90Mutex.Unlock()
+	fake.Method90Stub = nil
+	fake.method90Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method90ReturnsOnCall(i int, result1 error) {
+	fake.method90Mutex.Lock()
+	defer fake.method90Mutex.Unlock()
+	fake.Method90Stub = nil
+	if fake.method90ReturnsOnCall == nil {
+		fake.method90ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method90ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method91(arg1 int) error {
+	fake.method91Mutex.Lock()
+	stub := fake.Method91Stub
+	callIndex := len(fake.method91ArgsForCall)
+	ret, specificReturn := fake.method91ReturnsOnCall[callIndex]
+	fakeReturns := fake.method91Returns
+	fake.method91ArgsForCall = append(fake.method91ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method91Mutex.Unlock()
+	call := fake.recordInvocation("Method91", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method91Mutex.Lock()
+	if fake.method91ResultsForCall == nil {
+		fake.method91ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method91ResultsForCall[callIndex] = results
+	fake.method91Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method91CallCount() int {
+	fake.method91Mutex.RLock()
+	defer fake.method91Mutex.RUnlock()
+	return len(fake.method91ArgsForCall)
+}
+
+func (fake *FakeWide) Method91ArgsForCall(i int) int {
+	fake.method91Mutex.RLock()
+	defer fake.method91Mutex.RUnlock()
+	return fake.method91ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method91ResultsForCall(i int) error {
+	fake.method91Mutex.RLock()
+	defer fake.method91Mutex.RUnlock()
+	return fake.method91ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method91Calls(stub func(int) error) {
+	fake.method91Mutex.Lock()
+	defer fake.method91Mutex.Unlock()
+	fake.Method91Stub = stub
+}
+
+func (fake *FakeWide) Method91Returns(result1 error) {
+	fake.method91Mutex.Lock()
+	defer fake.method91Mutex.Unlock()
+	fake.Method91Stub = nil
+	fake.method91Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method91ReturnsOnCall(i int, result1 error) {
+	fake.method91Mutex.Lock()
+	defer fake.method91Mutex.Unlock()
+	fake.Method91Stub = nil
+	if fake.method91ReturnsOnCall == nil {
+		fake.method91ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method91ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method92(arg1 int) error {
+	fake.method92Mutex.Lock()
+	stub := fake.Method92Stub
+	callIndex := len(fake.method92ArgsForCall)
+	ret, specificReturn := fake.method92ReturnsOnCall[callIndex]
+	fakeReturns := fake.method92Returns
+	fake.method92ArgsForCall = append(fake.method92ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method92Mutex.Unlock()
+	call := fake.recordInvocation("Method92", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method92Mutex.Lock()
+	if fake.method92ResultsForCall == nil {
+		fake.method92ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method92ResultsForCall[callIndex] = results
+	fake.method92Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method92CallCount() int {
+	fake.method92Mutex.RLock()
+	defer fake.method92Mutex.RUnlock()
+	return len(fake.method92ArgsForCall)
+}
+
+func (fake *FakeWide) Method92ArgsForCall(i int) int {
+	fake.method92Mutex.RLock()
+	defer fake.method92Mutex.RUnlock()
+	return fake.method92ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method92ResultsForCall(i int) error {
+	fake.method92Mutex.RLock()
+	defer fake.method92Mutex.RUnlock()
+	return fake.method92ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method92Calls(stub func(int) error) {
+	fake.method92Mutex.Lock()
+	defer fake.method92Mutex.Unlock()
+	fake.Method92Stub = stub
+}
+
+func (fake *FakeWide) Method92Returns(result1 error) {
+	fake.method92Mutex.Lock()
+	defer fake.method92Mutex.Unlock()
+	fake.Method92Stub = nil
+	fake.method92Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method92ReturnsOnCall(i int, result1 error) {
+	fake.method92Mutex.Lock()
+	defer fake.method92Mutex.Unlock()
+	fake.Method92Stub = nil
+	if fake.method92ReturnsOnCall == nil {
+		fake.method92ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method92ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method93(arg1 int) error {
+	fake.method93Mutex.Lock()
+	stub := fake.Method93Stub
+	callIndex := len(fake.method93ArgsForCall)
+	ret, specificReturn := fake.method93ReturnsOnCall[callIndex]
+	fakeReturns := fake.method93Returns
+	fake.method93ArgsForCall = append(fake.method93ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method93Mutex.Unlock()
+	call := fake.recordInvocation("Method93", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method93Mutex.Lock()
+	if fake.method93ResultsForCall == nil {
+		fake.method93ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method93ResultsForCall[callIndex] = results
+	fake.method93Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method93CallCount() int {
+	fake.method93Mutex.RLock()
+	defer fake.method93Mutex.RUnlock()
+	return len(fake.method93ArgsForCall)
+}
+
+func (fake *FakeWide) Method93ArgsForCall(i int) int {
+	fake.method93Mutex.RLock()
+	defer fake.method93Mutex.RUnlock()
+	return fake.method93ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method93ResultsForCall(i int) error {
+	fake.method93Mutex.RLock()
+	defer fake.method93Mutex.RUnlock()
+	return fake.method93ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method93Calls(stub func(int) error) {
+	fake.method93Mutex.Lock()
+	defer fake.method93Mutex.Unlock()
+	fake.Method93Stub = stub
+}
+
+func (fake *FakeWide) Method93Returns(result1 error) {
+	fake.method93Mutex.Lock()
+	defer fake.method93Mutex.Unlock()
+	fake.Method93Stub = nil
+	fake.method93Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method93ReturnsOnCall(i int, result1 error) {
+	fake.method93Mutex.Lock()
+	defer fake.method93Mutex.Unlock()
+	fake.Method93Stub = nil
+	if fake.method93ReturnsOnCall == nil {
+		fake.method93ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method93ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method94(arg1 int) error {
+	fake.method94Mutex.Lock()
+	stub := fake.Method94Stub
+	callIndex := len(fake.method94ArgsForCall)
+	ret, specificReturn := fake.method94ReturnsOnCall[callIndex]
+	fakeReturns := fake.method94Returns
+	fake.method94ArgsForCall = append(fake.method94ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method94Mutex.Unlock()
+	call := fake.recordInvocation("Method94", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method94Mutex.Lock()
+	if fake.method94ResultsForCall == nil {
+		fake.method94ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method94ResultsForCall[callIndex] = results
+	fake.method94Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method94CallCount() int {
+	fake.method94Mutex.RLock()
+	defer fake.method94Mutex.RUnlock()
+	return len(fake.method94ArgsForCall)
+}
+
+func (fake *FakeWide) Method94ArgsForCall(i int) int {
+	fake.method94Mutex.RLock()
+	defer fake.method94Mutex.RUnlock()
+	return fake.method94ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method94ResultsForCall(i int) error {
+	fake.method94Mutex.RLock()
+	defer fake.method94Mutex.RUnlock()
+	return fake.method94ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method94Calls(stub func(int) error) {
+	fake.method94Mutex.Lock()
+	defer fake.method94Mutex.Unlock()
+	fake.Method94Stub = stub
+}
+
+func (fake *FakeWide) Method94Returns(result1 error) {
+	fake.method94Mutex.Lock()
+	defer fake.method94Mutex.Unlock()
+	fake.Method94Stub = nil
+	fake.method94Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method94ReturnsOnCall(i int, result1 error) {
+	fake.method94Mutex.Lock()
+	defer fake.method94Mutex.Unlock()
+	fake.Method94Stub = nil
+	if fake.method94ReturnsOnCall == nil {
+		fake.method94ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method94ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method95(arg1 int) error {
+	fake.method95Mutex.Lock()
+	stub := fake.Method95Stub
+	callIndex := len(fake.method95ArgsForCall)
+	ret, specificReturn := fake.method95ReturnsOnCall[callIndex]
+	fakeReturns := fake.method95Returns
+	fake.method95ArgsForCall = append(fake.method95ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method95Mutex.Unlock()
+	call := fake.recordInvocation("Method95", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method95Mutex.Lock()
+	if fake.method95ResultsForCall == nil {
+		fake.method95ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method95ResultsForCall[callIndex] = results
+	fake.method95Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method95CallCount() int {
+	fake.method95Mutex.RLock()
+	defer fake.method95Mutex.RUnlock()
+	return len(fake.method95ArgsForCall)
+}
+
+func (fake *FakeWide) Method95ArgsForCall(i int) int {
+	fake.method95Mutex.RLock()
+	defer fake.method95Mutex.RUnlock()
+	return fake.method95ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method95ResultsForCall(i int) error {
+	fake.method95Mutex.RLock()
+	defer fake.method95Mutex.RUnlock()
+	return fake.method95ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method95Calls(stub func(int) error) {
+	fake.method95Mutex.Lock()
+	defer fake.method95Mutex.Unlock()
+	fake.Method95Stub = stub
+}
+
+func (fake *FakeWide) Method95Returns(result1 error) {
+	fake.method95Mutex.Lock()
+	defer fake.method95Mutex.Unlock()
+	fake.Method95Stub = nil
+	fake.method95Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method95ReturnsOnCall(i int, result1 error) {
+	fake.method95Mutex.Lock()
+	defer fake.method95Mutex.Unlock()
+	fake.Method95Stub = nil
+	if fake.method95ReturnsOnCall == nil {
+		fake.method95ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method95ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method96(arg1 int) error {
+	fake.method96Mutex.Lock()
+	stub := fake.Method96Stub
+	callIndex := len(fake.method96ArgsForCall)
+	ret, specificReturn := fake.method96ReturnsOnCall[callIndex]
+	fakeReturns := fake.method96Returns
+	fake.method96ArgsForCall = append(fake.method96ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method96Mutex.Unlock()
+	call := fake.recordInvocation("Method96", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method96Mutex.Lock()
+	if fake.method96ResultsForCall == nil {
+		fake.method96ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method96ResultsForCall[callIndex] = results
+	fake.method96Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method96CallCount() int {
+	fake.method96Mutex.RLock()
+	defer fake.method96Mutex.RUnlock()
+	return len(fake.method96ArgsForCall)
+}
+
+func (fake *FakeWide) Method96ArgsForCall(i int) int {
+	fake.method96Mutex.RLock()
+	defer fake.method96Mutex.RUnlock()
+	return fake.method96ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method96ResultsForCall(i int) error {
+	fake.method96Mutex.RLock()
+	defer fake.method96Mutex.RUnlock()
+	return fake.method96ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method96Calls(stub func(int) error) {
+	fake.method96Mutex.Lock()
+	defer fake.method96Mutex.Unlock()
+	fake.Method96Stub = stub
+}
+
+func (fake *FakeWide) Method96Returns(result1 error) {
+	fake.method96Mutex.Lock()
+	defer fake.method96Mutex.Unlock()
+	fake.Method96Stub = nil
+	fake.method96Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method96ReturnsOnCall(i int, result1 error) {
+	fake.method96Mutex.Lock()
+	defer fake.method96Mutex.Unlock()
+	fake.Method96Stub = nil
+	if fake.method96ReturnsOnCall == nil {
+		fake.method96ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method96ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method97(arg1 int) error {
+	fake.method97Mutex.Lock()
+	stub := fake.Method97Stub
+	callIndex := len(fake.method97ArgsForCall)
+	ret, specificReturn := fake.method97ReturnsOnCall[callIndex]
+	fakeReturns := fake.method97Returns
+	fake.method97ArgsForCall = append(fake.method97ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method97Mutex.Unlock()
+	call := fake.recordInvocation("Method97", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method97Mutex.Lock()
+	if fake.method97ResultsForCall == nil {
+		fake.method97ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method97ResultsForCall[callIndex] = results
+	fake.method97Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method97CallCount() int {
+	fake.method97Mutex.RLock()
+	defer fake.method97Mutex.RUnlock()
+	return len(fake.method97ArgsForCall)
+}
+
+func (fake *FakeWide) Method97ArgsForCall(i int) int {
+	fake.method97Mutex.RLock()
+	defer fake.method97Mutex.RUnlock()
+	return fake.method97ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method97ResultsForCall(i int) error {
+	fake.method97Mutex.RLock()
+	defer fake.method97Mutex.RUnlock()
+	return fake.method97ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method97Calls(stub func(int) error) {
+	fake.method97Mutex.Lock()
+	defer fake.method97Mutex.Unlock()
+	fake.Method97Stub = stub
+}
+
+func (fake *FakeWide) Method97Returns(result1 error) {
+	fake.method97Mutex.Lock()
+	defer fake.method97Mutex.Unlock()
+	fake.Method97Stub = nil
+	fake.method97Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method97ReturnsOnCall(i int, result1 error) {
+	fake.method97Mutex.Lock()
+	defer fake.method97Mutex.Unlock()
+	fake.Method97Stub = nil
+	if fake.method97ReturnsOnCall == nil {
+		fake.method97ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method97ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method98(arg1 int) error {
+	fake.method98Mutex.Lock()
+	stub := fake.Method98Stub
+	callIndex := len(fake.method98ArgsForCall)
+	ret, specificReturn := fake.method98ReturnsOnCall[callIndex]
+	fakeReturns := fake.method98Returns
+	fake.method98ArgsForCall = append(fake.method98ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method98Mutex.Unlock()
+	call := fake.recordInvocation("Method98", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method98Mutex.Lock()
+	if fake.method98ResultsForCall == nil {
+		fake.method98ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method98ResultsForCall[callIndex] = results
+	fake.method98Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method98CallCount() int {
+	fake.method98Mutex.RLock()
+	defer fake.method98Mutex.RUnlock()
+	return len(fake.method98ArgsForCall)
+}
+
+func (fake *FakeWide) Method98ArgsForCall(i int) int {
+	fake.method98Mutex.RLock()
+	defer fake.method98Mutex.RUnlock()
+	return fake.method98ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method98ResultsForCall(i int) error {
+	fake.method98Mutex.RLock()
+	defer fake.method98Mutex.RUnlock()
+	return fake.method98ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method98Calls(stub func(int) error) {
+	fake.method98Mutex.Lock()
+	defer fake.method98Mutex.Unlock()
+	fake.Method98Stub = stub
+}
+
+func (fake *FakeWide) Method98Returns(result1 error) {
+	fake.method98Mutex.Lock()
+	defer fake.method98Mutex.Unlock()
+	fake.Method98Stub = nil
+	fake.method98Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method98ReturnsOnCall(i int, result1 error) {
+	fake.method98Mutex.Lock()
+	defer fake.method98Mutex.Unlock()
+	fake.Method98Stub = nil
+	if fake.method98ReturnsOnCall == nil {
+		fake.method98ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method98ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method99(arg1 int) error {
+	fake.method99Mutex.Lock()
+	stub := fake.Method99Stub
+	callIndex := len(fake.method99ArgsForCall)
+	ret, specificReturn := fake.method99ReturnsOnCall[callIndex]
+	fakeReturns := fake.method99Returns
+	fake.method99ArgsForCall = append(fake.method99ArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.method99Mutex.Unlock()
+	call := fake.recordInvocation("Method99", []interface{}{arg1})
+	results := fakeReturns
+	if specificReturn {
+		results = ret
+	}
+	if stub != nil {
+		results.result1 = stub(arg1)
+	}
+	fake.method99Mutex.Lock()
+	if fake.method99ResultsForCall == nil {
+		fake.method99ResultsForCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method99ResultsForCall[callIndex] = results
+	fake.method99Mutex.Unlock()
+	fake.recordResults(call, []interface{}{results.result1})
+	return results.result1
+}
+
+func (fake *FakeWide) Method99CallCount() int {
+	fake.method99Mutex.RLock()
+	defer fake.method99Mutex.RUnlock()
+	return len(fake.method99ArgsForCall)
+}
+
+func (fake *FakeWide) Method99ArgsForCall(i int) int {
+	fake.method99Mutex.RLock()
+	defer fake.method99Mutex.RUnlock()
+	return fake.method99ArgsForCall[i].arg1
+}
+
+func (fake *FakeWide) Method99ResultsForCall(i int) error {
+	fake.method99Mutex.RLock()
+	defer fake.method99Mutex.RUnlock()
+	return fake.method99ResultsForCall[i].result1
+}
+
+func (fake *FakeWide) Method99Calls(stub func(int) error) {
+	fake.method99Mutex.Lock()
+	defer fake.method99Mutex.Unlock()
+	fake.Method99Stub = stub
+}
+
+func (fake *FakeWide) Method99Returns(result1 error) {
+	fake.method99Mutex.Lock()
+	defer fake.method99Mutex.Unlock()
+	fake.Method99Stub = nil
+	fake.method99Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Method99ReturnsOnCall(i int, result1 error) {
+	fake.method99Mutex.Lock()
+	defer fake.method99Mutex.Unlock()
+	fake.Method99Stub = nil
+	if fake.method99ReturnsOnCall == nil {
+		fake.method99ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.method99ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWide) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = make([][]interface{}, len(value))
+		for i, args := range value {
+			copiedInvocations[key][i] = append([]interface{}{}, args...)
+		}
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWide) Calls() []FakeWideCall {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedCalls := make([]FakeWideCall, len(fake.calls))
+	for i, call := range fake.calls {
+		copiedCalls[i] = *call
+		copiedCalls[i].Args = append([]interface{}{}, call.Args...)
+		copiedCalls[i].Results = append([]interface{}{}, call.Results...)
+	}
+	return copiedCalls
+}
+
+func (fake *FakeWide) Reset() {
+	fake.method0Mutex.Lock()
+	fake.method0ArgsForCall = nil
+	fake.method0ResultsForCall = nil
+	fake.method0Mutex.Unlock()
+	fake.method1Mutex.Lock()
+	fake.method1ArgsForCall = nil
+	fake.method1ResultsForCall = nil
+	fake.method1Mutex.Unlock()
+	fake.method2Mutex.Lock()
+	fake.method2ArgsForCall = nil
+	fake.method2ResultsForCall = nil
+	fake.method2Mutex.Unlock()
+	fake.method3Mutex.Lock()
+	fake.method3ArgsForCall = nil
+	fake.method3ResultsForCall = nil
+	fake.method3Mutex.Unlock()
+	fake.method4Mutex.Lock()
+	fake.method4ArgsForCall = nil
+	fake.method4ResultsForCall = nil
+	fake.method4Mutex.Unlock()
+	fake.method5Mutex.Lock()
+	fake.method5ArgsForCall = nil
+	fake.method5ResultsForCall = nil
+	fake.method5Mutex.Unlock()
+	fake.method6Mutex.Lock()
+	fake.method6ArgsForCall = nil
+	fake.method6ResultsForCall = nil
+	fake.method6Mutex.Unlock()
+	fake.method7Mutex.Lock()
+	fake.method7ArgsForCall = nil
+	fake.method7ResultsForCall = nil
+	fake.method7Mutex.Unlock()
+	fake.method8Mutex.Lock()
+	fake.method8ArgsForCall = nil
+	fake.method8ResultsForCall = nil
+	fake.method8Mutex.Unlock()
+	fake.method9Mutex.Lock()
+	fake.method9ArgsForCall = nil
+	fake.method9ResultsForCall = nil
+	fake.method9Mutex.Unlock()
+	fake.method10Mutex.Lock()
+	fake.method10ArgsForCall = nil
+	fake.method10ResultsForCall = nil
+	fake.method10Mutex.Unlock()
+	fake.method11Mutex.Lock()
+	fake.method11ArgsForCall = nil
+	fake.method11ResultsForCall = nil
+	fake.method11Mutex.Unlock()
+	fake.method12Mutex.Lock()
+	fake.method12ArgsForCall = nil
+	fake.method12ResultsForCall = nil
+	fake.method12Mutex.Unlock()
+	fake.method13Mutex.Lock()
+	fake.method13ArgsForCall = nil
+	fake.method13ResultsForCall = nil
+	fake.method13Mutex.Unlock()
+	fake.method14Mutex.Lock()
+	fake.method14ArgsForCall = nil
+	fake.method14ResultsForCall = nil
+	fake.method14Mutex.Unlock()
+	fake.method15Mutex.Lock()
+	fake.method15ArgsForCall = nil
+	fake.method15ResultsForCall = nil
+	fake.method15Mutex.Unlock()
+	fake.method16Mutex.Lock()
+	fake.method16ArgsForCall = nil
+	fake.method16ResultsForCall = nil
+	fake.method16Mutex.Unlock()
+	fake.method17Mutex.Lock()
+	fake.method17ArgsForCall = nil
+	fake.method17ResultsForCall = nil
+	fake.method17Mutex.Unlock()
+	fake.method18Mutex.Lock()
+	fake.method18ArgsForCall = nil
+	fake.method18ResultsForCall = nil
+	fake.method18Mutex.Unlock()
+	fake.method19Mutex.Lock()
+	fake.method19ArgsForCall = nil
+	fake.method19ResultsForCall = nil
+	fake.method19Mutex.Unlock()
+	fake.method20Mutex.Lock()
+	fake.method20ArgsForCall = nil
+	fake.method20ResultsForCall = nil
+	fake.method20Mutex.Unlock()
+	fake.method21Mutex.Lock()
+	fake.method21ArgsForCall = nil
+	fake.method21ResultsForCall = nil
+	fake.method21Mutex.Unlock()
+	fake.method22Mutex.Lock()
+	fake.method22ArgsForCall = nil
+	fake.method22ResultsForCall = nil
+	fake.method22Mutex.Unlock()
+	fake.method23Mutex.Lock()
+	fake.method23ArgsForCall = nil
+	fake.method23ResultsForCall = nil
+	fake.method23Mutex.Unlock()
+	fake.method24Mutex.Lock()
+	fake.method24ArgsForCall = nil
+	fake.method24ResultsForCall = nil
+	fake.method24Mutex.Unlock()
+	fake.method25Mutex.Lock()
+	fake.method25ArgsForCall = nil
+	fake.method25ResultsForCall = nil
+	fake.method25Mutex.Unlock()
+	fake.method26Mutex.Lock()
+	fake.method26ArgsForCall = nil
+	fake.method26ResultsForCall = nil
+	fake.method26Mutex.Unlock()
+	fake.method27Mutex.Lock()
+	fake.method27ArgsForCall = nil
+	fake.method27ResultsForCall = nil
+	fake.method27Mutex.Unlock()
+	fake.method28Mutex.Lock()
+	fake.method28ArgsForCall = nil
+	fake.method28ResultsForCall = nil
+	fake.method28Mutex.Unlock()
+	fake.method29Mutex.Lock()
+	fake.method29ArgsForCall = nil
+	fake.method29ResultsForCall = nil
+	fake.method29Mutex.Unlock()
+	fake.method30Mutex.Lock()
+	fake.method30ArgsForCall = nil
+	fake.method30ResultsForCall = nil
+	fake.method30Mutex.Unlock()
+	fake.method31Mutex.Lock()
+	fake.method31ArgsForCall = nil
+	fake.method31ResultsForCall = nil
+	fake.method31Mutex.Unlock()
+	fake.method32Mutex.Lock()
+	fake.method32ArgsForCall = nil
+	fake.method32ResultsForCall = nil
+	fake.method32Mutex.Unlock()
+	fake.method33Mutex.Lock()
+	fake.method33ArgsForCall = nil
+	fake.method33ResultsForCall = nil
+	fake.method33Mutex.Unlock()
+	fake.method34Mutex.Lock()
+	fake.method34ArgsForCall = nil
+	fake.method34ResultsForCall = nil
+	fake.method34Mutex.Unlock()
+	fake.method35Mutex.Lock()
+	fake.method35ArgsForCall = nil
+	fake.method35ResultsForCall = nil
+	fake.method35Mutex.Unlock()
+	fake.method36Mutex.Lock()
+	fake.method36ArgsForCall = nil
+	fake.method36ResultsForCall = nil
+	fake.method36Mutex.Unlock()
+	fake.method37Mutex.Lock()
+	fake.method37ArgsForCall = nil
+	fake.method37ResultsForCall = nil
+	fake.method37Mutex.Unlock()
+	fake.method38Mutex.Lock()
+	fake.method38ArgsForCall = nil
+	fake.method38ResultsForCall = nil
+	fake.method38Mutex.Unlock()
+	fake.method39Mutex.Lock()
+	fake.method39ArgsForCall = nil
+	fake.method39ResultsForCall = nil
+	fake.method39Mutex.Unlock()
+	fake.method40Mutex.Lock()
+	fake.method40ArgsForCall = nil
+	fake.method40ResultsForCall = nil
+	fake.method40Mutex.Unlock()
+	fake.method41Mutex.Lock()
+	fake.method41ArgsForCall = nil
+	fake.method41ResultsForCall = nil
+	fake.method41Mutex.Unlock()
+	fake.method42Mutex.Lock()
+	fake.method42ArgsForCall = nil
+	fake.method42ResultsForCall = nil
+	fake.method42Mutex.Unlock()
+	fake.method43Mutex.Lock()
+	fake.method43ArgsForCall = nil
+	fake.method43ResultsForCall = nil
+	fake.method43Mutex.Unlock()
+	fake.method44Mutex.Lock()
+	fake.method44ArgsForCall = nil
+	fake.method44ResultsForCall = nil
+	fake.method44Mutex.Unlock()
+	fake.method45Mutex.Lock()
+	fake.method45ArgsForCall = nil
+	fake.method45ResultsForCall = nil
+	fake.method45Mutex.Unlock()
+	fake.method46Mutex.Lock()
+	fake.method46ArgsForCall = nil
+	fake.method46ResultsForCall = nil
+	fake.method46Mutex.Unlock()
+	fake.method47Mutex.Lock()
+	fake.method47ArgsForCall = nil
+	fake.method47ResultsForCall = nil
+	fake.method47Mutex.Unlock()
+	fake.method48Mutex.Lock()
+	fake.method48ArgsForCall = nil
+	fake.method48ResultsForCall = nil
+	fake.method48Mutex.Unlock()
+	fake.method49Mutex.Lock()
+	fake.method49ArgsForCall = nil
+	fake.method49ResultsForCall = nil
+	fake.method49Mutex.Unlock()
+	fake.method50Mutex.Lock()
+	fake.method50ArgsForCall = nil
+	fake.method50ResultsForCall = nil
+	fake.method50Mutex.Unlock()
+	fake.method51Mutex.Lock()
+	fake.method51ArgsForCall = nil
+	fake.method51ResultsForCall = nil
+	fake.method51Mutex.Unlock()
+	fake.method52Mutex.Lock()
+	fake.method52ArgsForCall = nil
+	fake.method52ResultsForCall = nil
+	fake.method52Mutex.Unlock()
+	fake.method53Mutex.Lock()
+	fake.method53ArgsForCall = nil
+	fake.method53ResultsForCall = nil
+	fake.method53Mutex.Unlock()
+	fake.method54Mutex.Lock()
+	fake.method54ArgsForCall = nil
+	fake.method54ResultsForCall = nil
+	fake.method54Mutex.Unlock()
+	fake.method55Mutex.Lock()
+	fake.method55ArgsForCall = nil
+	fake.method55ResultsForCall = nil
+	fake.method55Mutex.Unlock()
+	fake.method56Mutex.Lock()
+	fake.method56ArgsForCall = nil
+	fake.method56ResultsForCall = nil
+	fake.method56Mutex.Unlock()
+	fake.method57Mutex.Lock()
+	fake.method57ArgsForCall = nil
+	fake.method57ResultsForCall = nil
+	fake.method57Mutex.Unlock()
+	fake.method58Mutex.Lock()
+	fake.method58ArgsForCall = nil
+	fake.method58ResultsForCall = nil
+	fake.method58Mutex.Unlock()
+	fake.method59Mutex.Lock()
+	fake.method59ArgsForCall = nil
+	fake.method59ResultsForCall = nil
+	fake.method59Mutex.Unlock()
+	fake.method60Mutex.Lock()
+	fake.method60ArgsForCall = nil
+	fake.method60ResultsForCall = nil
+	fake.method60Mutex.Unlock()
+	fake.method61Mutex.Lock()
+	fake.method61ArgsForCall = nil
+	fake.method61ResultsForCall = nil
+	fake.method61Mutex.Unlock()
+	fake.method62Mutex.Lock()
+	fake.method62ArgsForCall = nil
+	fake.method62ResultsForCall = nil
+	fake.method62Mutex.Unlock()
+	fake.method63Mutex.Lock()
+	fake.method63ArgsForCall = nil
+	fake.method63ResultsForCall = nil
+	fake.method63Mutex.Unlock()
+	fake.method64Mutex.Lock()
+	fake.method64ArgsForCall = nil
+	fake.method64ResultsForCall = nil
+	fake.method64Mutex.Unlock()
+	fake.method65Mutex.Lock()
+	fake.method65ArgsForCall = nil
+	fake.method65ResultsForCall = nil
+	fake.method65Mutex.Unlock()
+	fake.method66Mutex.Lock()
+	fake.method66ArgsForCall = nil
+	fake.method66ResultsForCall = nil
+	fake.method66Mutex.Unlock()
+	fake.method67Mutex.Lock()
+	fake.method67ArgsForCall = nil
+	fake.method67ResultsForCall = nil
+	fake.method67Mutex.Unlock()
+	fake.method68Mutex.Lock()
+	fake.method68ArgsForCall = nil
+	fake.method68ResultsForCall = nil
+	fake.method68Mutex.Unlock()
+	fake.method69Mutex.Lock()
+	fake.method69ArgsForCall = nil
+	fake.method69ResultsForCall = nil
+	fake.method69Mutex.Unlock()
+	fake.method70Mutex.Lock()
+	fake.method70ArgsForCall = nil
+	fake.method70ResultsForCall = nil
+	fake.method70Mutex.Unlock()
+	fake.method71Mutex.Lock()
+	fake.method71ArgsForCall = nil
+	fake.method71ResultsForCall = nil
+	fake.method71Mutex.Unlock()
+	fake.method72Mutex.Lock()
+	fake.method72ArgsForCall = nil
+	fake.method72ResultsForCall = nil
+	fake.method72Mutex.Unlock()
+	fake.method73Mutex.Lock()
+	fake.method73ArgsForCall = nil
+	fake.method73ResultsForCall = nil
+	fake.method73Mutex.Unlock()
+	fake.method74Mutex.Lock()
+	fake.method74ArgsForCall = nil
+	fake.method74ResultsForCall = nil
+	fake.method74Mutex.Unlock()
+	fake.method75Mutex.Lock()
+	fake.method75ArgsForCall = nil
+	fake.method75ResultsForCall = nil
+	fake.method75Mutex.Unlock()
+	fake.method76Mutex.Lock()
+	fake.method76ArgsForCall = nil
+	fake.method76ResultsForCall = nil
+	fake.method76Mutex.Unlock()
+	fake.method77Mutex.Lock()
+	fake.method77ArgsForCall = nil
+	fake.method77ResultsForCall = nil
+	fake.method77Mutex.Unlock()
+	fake.method78Mutex.Lock()
+	fake.method78ArgsForCall = nil
+	fake.method78ResultsForCall = nil
+	fake.method78Mutex.Unlock()
+	fake.method79Mutex.Lock()
+	fake.method79ArgsForCall = nil
+	fake.method79ResultsForCall = nil
+	fake.method79Mutex.Unlock()
+	fake.method80Mutex.Lock()
+	fake.method80ArgsForCall = nil
+	fake.method80ResultsForCall = nil
+	fake.method80Mutex.Unlock()
+	fake.method81Mutex.Lock()
+	fake.method81ArgsForCall = nil
+	fake.method81ResultsForCall = nil
+	fake.method81Mutex.Unlock()
+	fake.method82Mutex.Lock()
+	fake.method82ArgsForCall = nil
+	fake.method82ResultsForCall = nil
+	fake.method82Mutex.Unlock()
+	fake.method83Mutex.Lock()
+	fake.method83ArgsForCall = nil
+	fake.method83ResultsForCall = nil
+	fake.method83Mutex.Unlock()
+	fake.method84Mutex.Lock()
+	fake.method84ArgsForCall = nil
+	fake.method84ResultsForCall = nil
+	fake.method84Mutex.Unlock()
+	fake.method85Mutex.Lock()
+	fake.method85ArgsForCall = nil
+	fake.method85ResultsForCall = nil
+	fake.method85Mutex.Unlock()
+	fake.method86Mutex.Lock()
+	fake.method86ArgsForCall = nil
+	fake.method86ResultsForCall = nil
+	fake.method86Mutex.Unlock()
+	fake.method87Mutex.Lock()
+	fake.method87ArgsForCall = nil
+	fake.method87ResultsForCall = nil
+	fake.method87Mutex.Unlock()
+	fake.method88Mutex.Lock()
+	fake.method88ArgsForCall = nil
+	fake.method88ResultsForCall = nil
+	fake.method88Mutex.Unlock()
+	fake.method89Mutex.Lock()
+	fake.method89ArgsForCall = nil
+	fake.method89ResultsForCall = nil
+	fake.method89Mutex.Unlock()
+	fake.method90Mutex.Lock()
+	fake.method90ArgsForCall = nil
+	fake.method90ResultsForCall = nil
+	fake.method90Mutex.Unlock()
+	fake.method91Mutex.Lock()
+	fake.method91ArgsForCall = nil
+	fake.method91ResultsForCall = nil
+	fake.method91Mutex.Unlock()
+	fake.method92Mutex.Lock()
+	fake.method92ArgsForCall = nil
+	fake.method92ResultsForCall = nil
+	fake.method92Mutex.Unlock()
+	fake.method93Mutex.Lock()
+	fake.method93ArgsForCall = nil
+	fake.method93ResultsForCall = nil
+	fake.method93Mutex.Unlock()
+	fake.method94Mutex.Lock()
+	fake.method94ArgsForCall = nil
+	fake.method94ResultsForCall = nil
+	fake.method94Mutex.Unlock()
+	fake.method95Mutex.Lock()
+	fake.method95ArgsForCall = nil
+	fake.method95ResultsForCall = nil
+	fake.method95Mutex.Unlock()
+	fake.method96Mutex.Lock()
+	fake.method96ArgsForCall = nil
+	fake.method96ResultsForCall = nil
+	fake.method96Mutex.Unlock()
+	fake.method97Mutex.Lock()
+	fake.method97ArgsForCall = nil
+	fake.method97ResultsForCall = nil
+	fake.method97Mutex.Unlock()
+	fake.method98Mutex.Lock()
+	fake.method98ArgsForCall = nil
+	fake.method98ResultsForCall = nil
+	fake.method98Mutex.Unlock()
+	fake.method99Mutex.Lock()
+	fake.method99ArgsForCall = nil
+	fake.method99ResultsForCall = nil
+	fake.method99Mutex.Unlock()
+	fake.invocationsMutex.Lock()
+	fake.invocations = nil
+	fake.calls = nil
+	fake.invocationsMutex.Unlock()
+}
+
+func (fake *FakeWide) ResetStubs() {
+	fake.method0Mutex.Lock()
+	fake.Method0Stub = nil
+	fake.method0Returns = struct {
+		result1 error
+	}{}
+	fake.method0ReturnsOnCall = nil
+	fake.method0Mutex.Unlock()
+	fake.method1Mutex.Lock()
+	fake.Method1Stub = nil
+	fake.method1Returns = struct {
+		result1 error
+	}{}
+	fake.method1ReturnsOnCall = nil
+	fake.method1Mutex.Unlock()
+	fake.method2Mutex.Lock()
+	fake.Method2Stub = nil
+	fake.method2Returns = struct {
+		result1 error
+	}{}
+	fake.method2ReturnsOnCall = nil
+	fake.method2Mutex.Unlock()
+	fake.method3Mutex.Lock()
+	fake.Method3Stub = nil
+	fake.method3Returns = struct {
+		result1 error
+	}{}
+	fake.method3ReturnsOnCall = nil
+	fake.method3Mutex.Unlock()
+	fake.method4Mutex.Lock()
+	fake.Method4Stub = nil
+	fake.method4Returns = struct {
+		result1 error
+	}{}
+	fake.method4ReturnsOnCall = nil
+	fake.method4Mutex.Unlock()
+	fake.method5Mutex.Lock()
+	fake.Method5Stub = nil
+	fake.method5Returns = struct {
+		result1 error
+	}{}
+	fake.method5ReturnsOnCall = nil
+	fake.method5Mutex.Unlock()
+	fake.method6Mutex.Lock()
+	fake.Method6Stub = nil
+	fake.method6Returns = struct {
+		result1 error
+	}{}
+	fake.method6ReturnsOnCall = nil
+	fake.method6Mutex.Unlock()
+	fake.method7Mutex.Lock()
+	fake.Method7Stub = nil
+	fake.method7Returns = struct {
+		result1 error
+	}{}
+	fake.method7ReturnsOnCall = nil
+	fake.method7Mutex.Unlock()
+	fake.method8Mutex.Lock()
+	fake.Method8Stub = nil
+	fake.method8Returns = struct {
+		result1 error
+	}{}
+	fake.method8ReturnsOnCall = nil
+	fake.method8Mutex.Unlock()
+	fake.method9Mutex.Lock()
+	fake.Method9Stub = nil
+	fake.method9Returns = struct {
+		result1 error
+	}{}
+	fake.method9ReturnsOnCall = nil
+	fake.method9Mutex.Unlock()
+	fake.method10Mutex.Lock()
+	fake.Method10Stub = nil
+	fake.method10Returns = struct {
+		result1 error
+	}{}
+	fake.method10ReturnsOnCall = nil
+	fake.method10Mutex.Unlock()
+	fake.method11Mutex.Lock()
+	fake.Method11Stub = nil
+	fake.method11Returns = struct {
+		result1 error
+	}{}
+	fake.method11ReturnsOnCall = nil
+	fake.method11Mutex.Unlock()
+	fake.method12Mutex.Lock()
+	fake.Method12Stub = nil
+	fake.method12Returns = struct {
+		result1 error
+	}{}
+	fake.method12ReturnsOnCall = nil
+	fake.method12Mutex.Unlock()
+	fake.method13Mutex.Lock()
+	fake.Method13Stub = nil
+	fake.method13Returns = struct {
+		result1 error
+	}{}
+	fake.method13ReturnsOnCall = nil
+	fake.method13Mutex.Unlock()
+	fake.method14Mutex.Lock()
+	fake.Method14Stub = nil
+	fake.method14Returns = struct {
+		result1 error
+	}{}
+	fake.method14ReturnsOnCall = nil
+	fake.method14Mutex.Unlock()
+	fake.method15Mutex.Lock()
+	fake.Method15Stub = nil
+	fake.method15Returns = struct {
+		result1 error
+	}{}
+	fake.method15ReturnsOnCall = nil
+	fake.method15Mutex.Unlock()
+	fake.method16Mutex.Lock()
+	fake.Method16Stub = nil
+	fake.method16Returns = struct {
+		result1 error
+	}{}
+	fake.method16ReturnsOnCall = nil
+	fake.method16Mutex.Unlock()
+	fake.method17Mutex.Lock()
+	fake.Method17Stub = nil
+	fake.method17Returns = struct {
+		result1 error
+	}{}
+	fake.method17ReturnsOnCall = nil
+	fake.method17Mutex.Unlock()
+	fake.method18Mutex.Lock()
+	fake.Method18Stub = nil
+	fake.method18Returns = struct {
+		result1 error
+	}{}
+	fake.method18ReturnsOnCall = nil
+	fake.method18Mutex.Unlock()
+	fake.method19Mutex.Lock()
+	fake.Method19Stub = nil
+	fake.method19Returns = struct {
+		result1 error
+	}{}
+	fake.method19ReturnsOnCall = nil
+	fake.method19Mutex.Unlock()
+	fake.method20Mutex.Lock()
+	fake.Method20Stub = nil
+	fake.method20Returns = struct {
+		result1 error
+	}{}
+	fake.method20ReturnsOnCall = nil
+	fake.method20Mutex.Unlock()
+	fake.method21Mutex.Lock()
+	fake.Method21Stub = nil
+	fake.method21Returns = struct {
+		result1 error
+	}{}
+	fake.method21ReturnsOnCall = nil
+	fake.method21Mutex.Unlock()
+	fake.method22Mutex.Lock()
+	fake.Method22Stub = nil
+	fake.method22Returns = struct {
+		result1 error
+	}{}
+	fake.method22ReturnsOnCall = nil
+	fake.method22Mutex.Unlock()
+	fake.method23Mutex.Lock()
+	fake.Method23Stub = nil
+	fake.method23Returns = struct {
+		result1 error
+	}{}
+	fake.method23ReturnsOnCall = nil
+	fake.method23Mutex.Unlock()
+	fake.method24Mutex.Lock()
+	fake.Method24Stub = nil
+	fake.method24Returns = struct {
+		result1 error
+	}{}
+	fake.method24ReturnsOnCall = nil
+	fake.method24Mutex.Unlock()
+	fake.method25Mutex.Lock()
+	fake.Method25Stub = nil
+	fake.method25Returns = struct {
+		result1 error
+	}{}
+	fake.method25ReturnsOnCall = nil
+	fake.method25Mutex.Unlock()
+	fake.method26Mutex.Lock()
+	fake.Method26Stub = nil
+	fake.method26Returns = struct {
+		result1 error
+	}{}
+	fake.method26ReturnsOnCall = nil
+	fake.method26Mutex.Unlock()
+	fake.method27Mutex.Lock()
+	fake.Method27Stub = nil
+	fake.method27Returns = struct {
+		result1 error
+	}{}
+	fake.method27ReturnsOnCall = nil
+	fake.method27Mutex.Unlock()
+	fake.method28Mutex.Lock()
+	fake.Method28Stub = nil
+	fake.method28Returns = struct {
+		result1 error
+	}{}
+	fake.method28ReturnsOnCall = nil
+	fake.method28Mutex.Unlock()
+	fake.method29Mutex.Lock()
+	fake.Method29Stub = nil
+	fake.method29Returns = struct {
+		result1 error
+	}{}
+	fake.method29ReturnsOnCall = nil
+	fake.method29Mutex.Unlock()
+	fake.method30Mutex.Lock()
+	fake.Method30Stub = nil
+	fake.method30Returns = struct {
+		result1 error
+	}{}
+	fake.method30ReturnsOnCall = nil
+	fake.method30Mutex.Unlock()
+	fake.method31Mutex.Lock()
+	fake.Method31Stub = nil
+	fake.method31Returns = struct {
+		result1 error
+	}{}
+	fake.method31ReturnsOnCall = nil
+	fake.method31Mutex.Unlock()
+	fake.method32Mutex.Lock()
+	fake.Method32Stub = nil
+	fake.method32Returns = struct {
+		result1 error
+	}{}
+	fake.method32ReturnsOnCall = nil
+	fake.method32Mutex.Unlock()
+	fake.method33Mutex.Lock()
+	fake.Method33Stub = nil
+	fake.method33Returns = struct {
+		result1 error
+	}{}
+	fake.method33ReturnsOnCall = nil
+	fake.method33Mutex.Unlock()
+	fake.method34Mutex.Lock()
+	fake.Method34Stub = nil
+	fake.method34Returns = struct {
+		result1 error
+	}{}
+	fake.method34ReturnsOnCall = nil
+	fake.method34Mutex.Unlock()
+	fake.method35Mutex.Lock()
+	fake.Method35Stub = nil
+	fake.method35Returns = struct {
+		result1 error
+	}{}
+	fake.method35ReturnsOnCall = nil
+	fake.method35Mutex.Unlock()
+	fake.method36Mutex.Lock()
+	fake.Method36Stub = nil
+	fake.method36Returns = struct {
+		result1 error
+	}{}
+	fake.method36ReturnsOnCall = nil
+	fake.method36Mutex.Unlock()
+	fake.method37Mutex.Lock()
+	fake.Method37Stub = nil
+	fake.method37Returns = struct {
+		result1 error
+	}{}
+	fake.method37ReturnsOnCall = nil
+	fake.method37Mutex.Unlock()
+	fake.method38Mutex.Lock()
+	fake.Method38Stub = nil
+	fake.method38Returns = struct {
+		result1 error
+	}{}
+	fake.method38ReturnsOnCall = nil
+	fake.method38Mutex.Unlock()
+	fake.method39Mutex.Lock()
+	fake.Method39Stub = nil
+	fake.method39Returns = struct {
+		result1 error
+	}{}
+	fake.method39ReturnsOnCall = nil
+	fake.method39Mutex.Unlock()
+	fake.method40Mutex.Lock()
+	fake.Method40Stub = nil
+	fake.method40Returns = struct {
+		result1 error
+	}{}
+	fake.method40ReturnsOnCall = nil
+	fake.method40Mutex.Unlock()
+	fake.method41Mutex.Lock()
+	fake.Method41Stub = nil
+	fake.method41Returns = struct {
+		result1 error
+	}{}
+	fake.method41ReturnsOnCall = nil
+	fake.method41Mutex.Unlock()
+	fake.method42Mutex.Lock()
+	fake.Method42Stub = nil
+	fake.method42Returns = struct {
+		result1 error
+	}{}
+	fake.method42ReturnsOnCall = nil
+	fake.method42Mutex.Unlock()
+	fake.method43Mutex.Lock()
+	fake.Method43Stub = nil
+	fake.method43Returns = struct {
+		result1 error
+	}{}
+	fake.method43ReturnsOnCall = nil
+	fake.method43Mutex.Unlock()
+	fake.method44Mutex.Lock()
+	fake.Method44Stub = nil
+	fake.method44Returns = struct {
+		result1 error
+	}{}
+	fake.method44ReturnsOnCall = nil
+	fake.method44Mutex.Unlock()
+	fake.method45Mutex.Lock()
+	fake.Method45Stub = nil
+	fake.method45Returns = struct {
+		result1 error
+	}{}
+	fake.method45ReturnsOnCall = nil
+	fake.method45Mutex.Unlock()
+	fake.method46Mutex.Lock()
+	fake.Method46Stub = nil
+	fake.method46Returns = struct {
+		result1 error
+	}{}
+	fake.method46ReturnsOnCall = nil
+	fake.method46Mutex.Unlock()
+	fake.method47Mutex.Lock()
+	fake.Method47Stub = nil
+	fake.method47Returns = struct {
+		result1 error
+	}{}
+	fake.method47ReturnsOnCall = nil
+	fake.method47Mutex.Unlock()
+	fake.method48Mutex.Lock()
+	fake.Method48Stub = nil
+	fake.method48Returns = struct {
+		result1 error
+	}{}
+	fake.method48ReturnsOnCall = nil
+	fake.method48Mutex.Unlock()
+	fake.method49Mutex.Lock()
+	fake.Method49Stub = nil
+	fake.method49Returns = struct {
+		result1 error
+	}{}
+	fake.method49ReturnsOnCall = nil
+	fake.method49Mutex.Unlock()
+	fake.method50Mutex.Lock()
+	fake.Method50Stub = nil
+	fake.method50Returns = struct {
+		result1 error
+	}{}
+	fake.method50ReturnsOnCall = nil
+	fake.method50Mutex.Unlock()
+	fake.method51Mutex.Lock()
+	fake.Method51Stub = nil
+	fake.method51Returns = struct {
+		result1 error
+	}{}
+	fake.method51ReturnsOnCall = nil
+	fake.method51Mutex.Unlock()
+	fake.method52Mutex.Lock()
+	fake.Method52Stub = nil
+	fake.method52Returns = struct {
+		result1 error
+	}{}
+	fake.method52ReturnsOnCall = nil
+	fake.method52Mutex.Unlock()
+	fake.method53Mutex.Lock()
+	fake.Method53Stub = nil
+	fake.method53Returns = struct {
+		result1 error
+	}{}
+	fake.method53ReturnsOnCall = nil
+	fake.method53Mutex.Unlock()
+	fake.method54Mutex.Lock()
+	fake.Method54Stub = nil
+	fake.method54Returns = struct {
+		result1 error
+	}{}
+	fake.method54ReturnsOnCall = nil
+	fake.method54Mutex.Unlock()
+	fake.method55Mutex.Lock()
+	fake.Method55Stub = nil
+	fake.method55Returns = struct {
+		result1 error
+	}{}
+	fake.method55ReturnsOnCall = nil
+	fake.method55Mutex.Unlock()
+	fake.method56Mutex.Lock()
+	fake.Method56Stub = nil
+	fake.method56Returns = struct {
+		result1 error
+	}{}
+	fake.method56ReturnsOnCall = nil
+	fake.method56Mutex.Unlock()
+	fake.method57Mutex.Lock()
+	fake.Method57Stub = nil
+	fake.method57Returns = struct {
+		result1 error
+	}{}
+	fake.method57ReturnsOnCall = nil
+	fake.method57Mutex.Unlock()
+	fake.method58Mutex.Lock()
+	fake.Method58Stub = nil
+	fake.method58Returns = struct {
+		result1 error
+	}{}
+	fake.method58ReturnsOnCall = nil
+	fake.method58Mutex.Unlock()
+	fake.method59Mutex.Lock()
+	fake.Method59Stub = nil
+	fake.method59Returns = struct {
+		result1 error
+	}{}
+	fake.method59ReturnsOnCall = nil
+	fake.method59Mutex.Unlock()
+	fake.method60Mutex.Lock()
+	fake.Method60Stub = nil
+	fake.method60Returns = struct {
+		result1 error
+	}{}
+	fake.method60ReturnsOnCall = nil
+	fake.method60Mutex.Unlock()
+	fake.method61Mutex.Lock()
+	fake.Method61Stub = nil
+	fake.method61Returns = struct {
+		result1 error
+	}{}
+	fake.method61ReturnsOnCall = nil
+	fake.method61Mutex.Unlock()
+	fake.method62Mutex.Lock()
+	fake.Method62Stub = nil
+	fake.method62Returns = struct {
+		result1 error
+	}{}
+	fake.method62ReturnsOnCall = nil
+	fake.method62Mutex.Unlock()
+	fake.method63Mutex.Lock()
+	fake.Method63Stub = nil
+	fake.method63Returns = struct {
+		result1 error
+	}{}
+	fake.method63ReturnsOnCall = nil
+	fake.method63Mutex.Unlock()
+	fake.method64Mutex.Lock()
+	fake.Method64Stub = nil
+	fake.method64Returns = struct {
+		result1 error
+	}{}
+	fake.method64ReturnsOnCall = nil
+	fake.method64Mutex.Unlock()
+	fake.method65Mutex.Lock()
+	fake.Method65Stub = nil
+	fake.method65Returns = struct {
+		result1 error
+	}{}
+	fake.method65ReturnsOnCall = nil
+	fake.method65Mutex.Unlock()
+	fake.method66Mutex.Lock()
+	fake.Method66Stub = nil
+	fake.method66Returns = struct {
+		result1 error
+	}{}
+	fake.method66ReturnsOnCall = nil
+	fake.method66Mutex.Unlock()
+	fake.method67Mutex.Lock()
+	fake.Method67Stub = nil
+	fake.method67Returns = struct {
+		result1 error
+	}{}
+	fake.method67ReturnsOnCall = nil
+	fake.method67Mutex.Unlock()
+	fake.method68Mutex.Lock()
+	fake.Method68Stub = nil
+	fake.method68Returns = struct {
+		result1 error
+	}{}
+	fake.method68ReturnsOnCall = nil
+	fake.method68Mutex.Unlock()
+	fake.method69Mutex.Lock()
+	fake.Method69Stub = nil
+	fake.method69Returns = struct {
+		result1 error
+	}{}
+	fake.method69ReturnsOnCall = nil
+	fake.method69Mutex.Unlock()
+	fake.method70Mutex.Lock()
+	fake.Method70Stub = nil
+	fake.method70Returns = struct {
+		result1 error
+	}{}
+	fake.method70ReturnsOnCall = nil
+	fake.method70Mutex.Unlock()
+	fake.method71Mutex.Lock()
+	fake.Method71Stub = nil
+	fake.method71Returns = struct {
+		result1 error
+	}{}
+	fake.method71ReturnsOnCall = nil
+	fake.method71Mutex.Unlock()
+	fake.method72Mutex.Lock()
+	fake.Method72Stub = nil
+	fake.method72Returns = struct {
+		result1 error
+	}{}
+	fake.method72ReturnsOnCall = nil
+	fake.method72Mutex.Unlock()
+	fake.method73Mutex.Lock()
+	fake.Method73Stub = nil
+	fake.method73Returns = struct {
+		result1 error
+	}{}
+	fake.method73ReturnsOnCall = nil
+	fake.method73Mutex.Unlock()
+	fake.method74Mutex.Lock()
+	fake.Method74Stub = nil
+	fake.method74Returns = struct {
+		result1 error
+	}{}
+	fake.method74ReturnsOnCall = nil
+	fake.method74Mutex.Unlock()
+	fake.method75Mutex.Lock()
+	fake.Method75Stub = nil
+	fake.method75Returns = struct {
+		result1 error
+	}{}
+	fake.method75ReturnsOnCall = nil
+	fake.method75Mutex.Unlock()
+	fake.method76Mutex.Lock()
+	fake.Method76Stub = nil
+	fake.method76Returns = struct {
+		result1 error
+	}{}
+	fake.method76ReturnsOnCall = nil
+	fake.method76Mutex.Unlock()
+	fake.method77Mutex.Lock()
+	fake.Method77Stub = nil
+	fake.method77Returns = struct {
+		result1 error
+	}{}
+	fake.method77ReturnsOnCall = nil
+	fake.method77Mutex.Unlock()
+	fake.method78Mutex.Lock()
+	fake.Method78Stub = nil
+	fake.method78Returns = struct {
+		result1 error
+	}{}
+	fake.method78ReturnsOnCall = nil
+	fake.method78Mutex.Unlock()
+	fake.method79Mutex.Lock()
+	fake.Method79Stub = nil
+	fake.method79Returns = struct {
+		result1 error
+	}{}
+	fake.method79ReturnsOnCall = nil
+	fake.method79Mutex.Unlock()
+	fake.method80Mutex.Lock()
+	fake.Method80Stub = nil
+	fake.method80Returns = struct {
+		result1 error
+	}{}
+	fake.method80ReturnsOnCall = nil
+	fake.method80Mutex.Unlock()
+	fake.method81Mutex.Lock()
+	fake.Method81Stub = nil
+	fake.method81Returns = struct {
+		result1 error
+	}{}
+	fake.method81ReturnsOnCall = nil
+	fake.method81Mutex.Unlock()
+	fake.method82Mutex.Lock()
+	fake.Method82Stub = nil
+	fake.method82Returns = struct {
+		result1 error
+	}{}
+	fake.method82ReturnsOnCall = nil
+	fake.method82Mutex.Unlock()
+	fake.method83Mutex.Lock()
+	fake.Method83Stub = nil
+	fake.method83Returns = struct {
+		result1 error
+	}{}
+	fake.method83ReturnsOnCall = nil
+	fake.method83Mutex.Unlock()
+	fake.method84Mutex.Lock()
+	fake.Method84Stub = nil
+	fake.method84Returns = struct {
+		result1 error
+	}{}
+	fake.method84ReturnsOnCall = nil
+	fake.method84Mutex.Unlock()
+	fake.method85Mutex.Lock()
+	fake.Method85Stub = nil
+	fake.method85Returns = struct {
+		result1 error
+	}{}
+	fake.method85ReturnsOnCall = nil
+	fake.method85Mutex.Unlock()
+	fake.method86Mutex.Lock()
+	fake.Method86Stub = nil
+	fake.method86Returns = struct {
+		result1 error
+	}{}
+	fake.method86ReturnsOnCall = nil
+	fake.method86Mutex.Unlock()
+	fake.method87Mutex.Lock()
+	fake.Method87Stub = nil
+	fake.method87Returns = struct {
+		result1 error
+	}{}
+	fake.method87ReturnsOnCall = nil
+	fake.method87Mutex.Unlock()
+	fake.method88Mutex.Lock()
+	fake.Method88Stub = nil
+	fake.method88Returns = struct {
+		result1 error
+	}{}
+	fake.method88ReturnsOnCall = nil
+	fake.method88Mutex.Unlock()
+	fake.method89Mutex.Lock()
+	fake.Method89Stub = nil
+	fake.method89Returns = struct {
+		result1 error
+	}{}
+	fake.method89ReturnsOnCall = nil
+	fake.method89Mutex.Unlock()
+	fake.method90Mutex.Lock()
+	fake.Method90Stub = nil
+	fake.method90Returns = struct {
+		result1 error
+	}{}
+	fake.method90ReturnsOnCall = nil
+	fake.method90Mutex.Unlock()
+	fake.method91Mutex.Lock()
+	fake.Method91Stub = nil
+	fake.method91Returns = struct {
+		result1 error
+	}{}
+	fake.method91ReturnsOnCall = nil
+	fake.method91Mutex.Unlock()
+	fake.method92Mutex.Lock()
+	fake.Method92Stub = nil
+	fake.method92Returns = struct {
+		result1 error
+	}{}
+	fake.method92ReturnsOnCall = nil
+	fake.method92Mutex.Unlock()
+	fake.method93Mutex.Lock()
+	fake.Method93Stub = nil
+	fake.method93Returns = struct {
+		result1 error
+	}{}
+	fake.method93ReturnsOnCall = nil
+	fake.method93Mutex.Unlock()
+	fake.method94Mutex.Lock()
+	fake.Method94Stub = nil
+	fake.method94Returns = struct {
+		result1 error
+	}{}
+	fake.method94ReturnsOnCall = nil
+	fake.method94Mutex.Unlock()
+	fake.method95Mutex.Lock()
+	fake.Method95Stub = nil
+	fake.method95Returns = struct {
+		result1 error
+	}{}
+	fake.method95ReturnsOnCall = nil
+	fake.method95Mutex.Unlock()
+	fake.method96Mutex.Lock()
+	fake.Method96Stub = nil
+	fake.method96Returns = struct {
+		result1 error
+	}{}
+	fake.method96ReturnsOnCall = nil
+	fake.method96Mutex.Unlock()
+	fake.method97Mutex.Lock()
+	fake.Method97Stub = nil
+	fake.method97Returns = struct {
+		result1 error
+	}{}
+	fake.method97ReturnsOnCall = nil
+	fake.method97Mutex.Unlock()
+	fake.method98Mutex.Lock()
+	fake.Method98Stub = nil
+	fake.method98Returns = struct {
+		result1 error
+	}{}
+	fake.method98ReturnsOnCall = nil
+	fake.method98Mutex.Unlock()
+	fake.method99Mutex.Lock()
+	fake.Method99Stub = nil
+	fake.method99Returns = struct {
+		result1 error
+	}{}
+	fake.method99ReturnsOnCall = nil
+	fake.method99Mutex.Unlock()
+}
+
+func (fake *FakeWide) recordInvocation(key string, args []interface{}) *FakeWideCall {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	fake.callSeq++
+	call := &FakeWideCall{Method: key, Seq: fake.callSeq, Args: args}
+	fake.calls = append(fake.calls, call)
+	return call
+}
+
+func (fake *FakeWide) recordResults(call *FakeWideCall, results []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	call.Results = results
+}
+
+var _ fixtures.Wide = new(FakeWide)
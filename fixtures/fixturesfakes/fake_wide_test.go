@@ -0,0 +1,113 @@
+package fixturesfakes
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"unsafe"
+)
+
+func newWide() *FakeWide {
+	fake := new(FakeWide)
+	fake.Method0(0)
+	fake.Method50(50)
+	fake.Method99(99)
+	return fake
+}
+
+// methodMutexes returns the mutex of each of the fake's methods.
+func methodMutexes(fake *FakeWide) []*sync.RWMutex {
+	value := reflect.ValueOf(fake).Elem()
+	mutexType := reflect.TypeOf((*sync.RWMutex)(nil)).Elem()
+
+	var mutexes []*sync.RWMutex
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Type == mutexType && field.Name != "invocationsMutex" {
+			mutexes = append(mutexes, (*sync.RWMutex)(unsafe.Pointer(value.Field(i).UnsafeAddr())))
+		}
+	}
+
+	return mutexes
+}
+
+// lockPerMethodInvocations is Invocations as it was generated before calls
+// were recorded under invocationsMutex alone, when it also read locked the
+// mutex of every method for a consistent copy.
+func lockPerMethodInvocations(fake *FakeWide, mutexes []*sync.RWMutex) map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	for _, mutex := range mutexes {
+		mutex.RLock()
+		defer mutex.RUnlock()
+	}
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = make([][]interface{}, len(value))
+		for i, args := range value {
+			copiedInvocations[key][i] = append([]interface{}{}, args...)
+		}
+	}
+	return copiedInvocations
+}
+
+func BenchmarkInvocations(b *testing.B) {
+	b.Run("lock per method", func(b *testing.B) {
+		fake := newWide()
+		mutexes := methodMutexes(fake)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			lockPerMethodInvocations(fake, mutexes)
+		}
+	})
+
+	b.Run("invocations lock", func(b *testing.B) {
+		fake := newWide()
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			fake.Invocations()
+		}
+	})
+}
+
+func BenchmarkInvocationsParallel(b *testing.B) {
+	b.Run("lock per method", func(b *testing.B) {
+		fake := newWide()
+		mutexes := methodMutexes(fake)
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				lockPerMethodInvocations(fake, mutexes)
+			}
+		})
+	})
+
+	b.Run("invocations lock", func(b *testing.B) {
+		fake := newWide()
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				fake.Invocations()
+			}
+		})
+	})
+}
+
+func BenchmarkCall(b *testing.B) {
+	fake := new(FakeWide)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fake.Method0(i)
+	}
+}
+
+func TestMethodMutexes(t *testing.T) {
+	if n := len(methodMutexes(new(FakeWide))); n != 100 {
+		t.Fatalf("found %d method mutexes, expected 100", n)
+	}
+}
@@ -0,0 +1,13 @@
+package fixturesfakes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestFixturesfakes(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Fixturesfakes Suite")
+}
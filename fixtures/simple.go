@@ -1,12 +1,14 @@
 package fixtures
 
+import "io"
+
 type Simple interface {
 	A()
 	// B(b int)
 	// C(c int) (i int)
 
-	// io.Writer
-	// Embedded
+	io.Writer
+	Embedded
 }
 
 type Embedded interface {
@@ -0,0 +1,26 @@
+package fixtures
+
+import (
+	"net"
+	"sync"
+)
+
+//go:generate go run github.com/krishicks/margarine/cmd . Store
+//go:generate go run github.com/krishicks/margarine/cmd -strict -deep-copy-args -name StrictStore -o fixturesfakes/fake_strict_store.go . Store
+
+// Store is faked with the default options and, as StrictStore, with strict
+// and deep-copy-args, for the tests of fixturesfakes to run both.
+type Store interface {
+	Get(key string) (*Record, bool)
+	Put(key string, record *Record, tags map[string][]string) error
+	Allow(ips ...net.IP)
+	Lock(mu *sync.Mutex)
+	Close()
+}
+
+// Record is what a Store keeps.
+type Record struct {
+	Name   string
+	Labels map[string]string
+	Parent *Record
+}
@@ -0,0 +1,108 @@
+package fixtures
+
+//go:generate go run github.com/krishicks/margarine/cmd . Wide
+
+// Wide has enough methods to show how the cost of a fake grows with the size
+// of the interface it fakes.
+type Wide interface {
+	Method0(i int) error
+	Method1(i int) error
+	Method2(i int) error
+	Method3(i int) error
+	Method4(i int) error
+	Method5(i int) error
+	Method6(i int) error
+	Method7(i int) error
+	Method8(i int) error
+	Method9(i int) error
+	Method10(i int) error
+	Method11(i int) error
+	Method12(i int) error
+	Method13(i int) error
+	Method14(i int) error
+	Method15(i int) error
+	Method16(i int) error
+	Method17(i int) error
+	Method18(i int) error
+	Method19(i int) error
+	Method20(i int) error
+	Method21(i int) error
+	Method22(i int) error
+	Method23(i int) error
+	Method24(i int) error
+	Method25(i int) error
+	Method26(i int) error
+	Method27(i int) error
+	Method28(i int) error
+	Method29(i int) error
+	Method30(i int) error
+	Method31(i int) error
+	Method32(i int) error
+	Method33(i int) error
+	Method34(i int) error
+	Method35(i int) error
+	Method36(i int) error
+	Method37(i int) error
+	Method38(i int) error
+	Method39(i int) error
+	Method40(i int) error
+	Method41(i int) error
+	Method42(i int) error
+	Method43(i int) error
+	Method44(i int) error
+	Method45(i int) error
+	Method46(i int) error
+	Method47(i int) error
+	Method48(i int) error
+	Method49(i int) error
+	Method50(i int) error
+	Method51(i int) error
+	Method52(i int) error
+	Method53(i int) error
+	Method54(i int) error
+	Method55(i int) error
+	Method56(i int) error
+	Method57(i int) error
+	Method58(i int) error
+	Method59(i int) error
+	Method60(i int) error
+	Method61(i int) error
+	Method62(i int) error
+	Method63(i int) error
+	Method64(i int) error
+	Method65(i int) error
+	Method66(i int) error
+	Method67(i int) error
+	Method68(i int) error
+	Method69(i int) error
+	Method70(i int) error
+	Method71(i int) error
+	Method72(i int) error
+	Method73(i int) error
+	Method74(i int) error
+	Method75(i int) error
+	Method76(i int) error
+	Method77(i int) error
+	Method78(i int) error
+	Method79(i int) error
+	Method80(i int) error
+	Method81(i int) error
+	Method82(i int) error
+	Method83(i int) error
+	Method84(i int) error
+	Method85(i int) error
+	Method86(i int) error
+	Method87(i int) error
+	Method88(i int) error
+	Method89(i int) error
+	Method90(i int) error
+	Method91(i int) error
+	Method92(i int) error
+	Method93(i int) error
+	Method94(i int) error
+	Method95(i int) error
+	Method96(i int) error
+	Method97(i int) error
+	Method98(i int) error
+	Method99(i int) error
+}
@@ -0,0 +1,404 @@
+package margarine
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+)
+
+// Flatten returns the methods of the interface declared by typeSpec in file,
+// including those of any interfaces it embeds. files are all of the files in
+// the package, which is where embedded interfaces such as Embedded are looked
+// up; embedded interfaces such as io.Writer are loaded from the source of the
+// imported package, with their types qualified so they can be used alongside
+// the others. Methods that appear through more than one embed are included
+// once.
+//
+// The returned import specs are those needed by the returned methods.
+func Flatten(fset *token.FileSet, files []*ast.File, file *ast.File, typeSpec *ast.TypeSpec) ([]*ast.Field, []*ast.ImportSpec, error) {
+	f := &flattener{
+		fset:  fset,
+		iface: typeSpec.Name.Name,
+		seen:  map[string]bool{},
+	}
+
+	iface, ok := typeSpec.Type.(*ast.InterfaceType)
+	if !ok {
+		return nil, nil, f.error(typeSpec.Pos(), &NotAnInterfaceError{Name: typeSpec.Name.Name})
+	}
+
+	err := f.flatten(&scope{files: files, file: file}, iface)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return f.methods, f.imports, nil
+}
+
+// StructFromMethods returns the declaration of an empty struct named
+// structName and a declaration for each of methods, the input Fakify expects.
+// typeParams are the type parameters of a generic interface, and may be nil.
+func StructFromMethods(structName string, typeParams *ast.FieldList, methods []*ast.Field) (*ast.GenDecl, []*ast.FuncDecl) {
+	genDecl := &ast.GenDecl{
+		Tok: token.TYPE,
+		Specs: []ast.Spec{
+			&ast.TypeSpec{
+				Name:       ast.NewIdent(structName),
+				TypeParams: typeParams,
+				Type: &ast.StructType{
+					Fields: &ast.FieldList{},
+				},
+			},
+		},
+	}
+
+	var funcDecls []*ast.FuncDecl
+	for _, method := range methods {
+		funcDecls = append(funcDecls, &ast.FuncDecl{
+			Name: ast.NewIdent(method.Names[0].Name),
+			Type: method.Type.(*ast.FuncType),
+			Body: &ast.BlockStmt{},
+		})
+	}
+
+	return genDecl, funcDecls
+}
+
+type flattener struct {
+	fset    *token.FileSet
+	iface   string
+	seen    map[string]bool
+	methods []*ast.Field
+	imports []*ast.ImportSpec
+}
+
+// scope is where an interface being flattened was declared.
+type scope struct {
+	files []*ast.File
+	file  *ast.File
+
+	// pkgName is the name that types declared in files must be qualified
+	// with, and is empty for the package being faked.
+	pkgName string
+	pkgPath string
+
+	// typeParams are the names of the type parameters of the interface,
+	// which hide types of the same names declared in files.
+	typeParams map[string]bool
+}
+
+func (f *flattener) flatten(s *scope, iface *ast.InterfaceType) error {
+	for _, field := range iface.Methods.List {
+		if len(field.Names) > 0 {
+			name := field.Names[0].Name
+			if f.seen[name] {
+				continue
+			}
+			f.seen[name] = true
+
+			if s.pkgName != "" && !token.IsExported(name) {
+				return f.error(field.Pos(), &UnsupportedError{Construct: fmt.Sprintf("unexported method %s, which a fake outside package %s cannot implement", name, s.pkgName)})
+			}
+
+			funcType, err := f.copyType(s, field.Type)
+			if err != nil {
+				return err
+			}
+
+			f.methods = append(f.methods, &ast.Field{
+				Names: []*ast.Ident{ast.NewIdent(name)},
+				Type:  funcType,
+			})
+			continue
+		}
+
+		e, err := f.embedded(s, field.Type)
+		if err != nil {
+			return err
+		}
+
+		if e == nil {
+			continue
+		}
+
+		err = f.flatten(e.scope, e.iface)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type embed struct {
+	scope *scope
+	iface *ast.InterfaceType
+}
+
+func (f *flattener) embedded(s *scope, expr ast.Expr) (*embed, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		file, typeSpec := findTypeSpec(s.files, t.Name)
+		if typeSpec == nil {
+			switch t.Name {
+			case "any":
+				return nil, nil
+			case "error":
+				// error is predeclared as interface { Error() string }
+				return &embed{
+					scope: s,
+					iface: &ast.InterfaceType{
+						Methods: &ast.FieldList{
+							List: []*ast.Field{{
+								Names: []*ast.Ident{ast.NewIdent("Error")},
+								Type: &ast.FuncType{
+									Params: &ast.FieldList{},
+									Results: &ast.FieldList{
+										List: []*ast.Field{{Type: ast.NewIdent("string")}},
+									},
+								},
+							}},
+						},
+					},
+				}, nil
+			}
+
+			return nil, f.error(t.Pos(), fmt.Errorf("unable to find embedded interface %s", t.Name))
+		}
+
+		iface, ok := typeSpec.Type.(*ast.InterfaceType)
+		if !ok {
+			return nil, f.error(t.Pos(), &NotAnInterfaceError{Name: t.Name})
+		}
+
+		return &embed{
+			scope: &scope{files: s.files, file: file, pkgName: s.pkgName, pkgPath: s.pkgPath},
+			iface: iface,
+		}, nil
+
+	case *ast.SelectorExpr:
+		pkgIdent, ok := t.X.(*ast.Ident)
+		if !ok {
+			return nil, f.error(t.Pos(), &UnsupportedError{Construct: describe(t)})
+		}
+
+		importSpec := findImportSpec(s.file, pkgIdent.Name)
+		if importSpec == nil {
+			return nil, f.error(t.Pos(), fmt.Errorf("unable to resolve package %s", pkgIdent.Name))
+		}
+
+		importPath, err := strconv.Unquote(importSpec.Path.Value)
+		if err != nil {
+			return nil, f.error(importSpec.Pos(), err)
+		}
+
+		files, err := f.parseImport(s.file, importPath)
+		if err != nil {
+			return nil, f.error(t.Pos(), err)
+		}
+
+		file, typeSpec := findTypeSpec(files, t.Sel.Name)
+		if typeSpec == nil {
+			return nil, f.error(t.Pos(), fmt.Errorf("unable to find embedded interface %s.%s", pkgIdent.Name, t.Sel.Name))
+		}
+
+		iface, ok := typeSpec.Type.(*ast.InterfaceType)
+		if !ok {
+			return nil, f.error(t.Pos(), &NotAnInterfaceError{Name: pkgIdent.Name + "." + t.Sel.Name})
+		}
+
+		f.addImport(importSpec)
+
+		return &embed{
+			scope: &scope{files: files, file: file, pkgName: pkgIdent.Name, pkgPath: importPath},
+			iface: iface,
+		}, nil
+	}
+
+	return nil, f.error(expr.Pos(), &UnsupportedError{Construct: describe(expr)})
+}
+
+func (f *flattener) error(pos token.Pos, err error) error {
+	return &Error{
+		Pos:       f.fset.Position(pos),
+		Interface: f.iface,
+		Err:       err,
+	}
+}
+
+func describe(expr ast.Expr) string {
+	switch expr.(type) {
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		return "embedded generic interface"
+	case *ast.BinaryExpr:
+		return "type union"
+	case *ast.UnaryExpr:
+		return "approximation constraint"
+	}
+	return fmt.Sprintf("type %T", expr)
+}
+
+func (f *flattener) parseImport(from *ast.File, importPath string) ([]*ast.File, error) {
+	srcDir := filepath.Dir(f.fset.Position(from.Package).Filename)
+
+	pkg, err := build.Import(importPath, srcDir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, name := range pkg.GoFiles {
+		file, err := parser.ParseFile(f.fset, filepath.Join(pkg.Dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+func (f *flattener) addImport(importSpec *ast.ImportSpec) {
+	for _, existing := range f.imports {
+		if existing.Path.Value == importSpec.Path.Value && existing.Name.String() == importSpec.Name.String() {
+			return
+		}
+	}
+
+	f.imports = append(f.imports, importSpec)
+}
+
+// copyType returns a copy of expr, qualifying the types it refers to that are
+// declared in another package being flattened.
+func (f *flattener) copyType(s *scope, expr ast.Expr) (ast.Expr, error) {
+	var err error
+	var copyExpr = func(e ast.Expr) ast.Expr {
+		if e == nil || err != nil {
+			return e
+		}
+		var c ast.Expr
+		c, err = f.copyType(s, e)
+		return c
+	}
+
+	var copyFields = func(fl *ast.FieldList) *ast.FieldList {
+		if fl == nil {
+			return nil
+		}
+		result := &ast.FieldList{}
+		for _, field := range fl.List {
+			var names []*ast.Ident
+			for _, name := range field.Names {
+				names = append(names, ast.NewIdent(name.Name))
+			}
+			result.List = append(result.List, &ast.Field{
+				Names: names,
+				Type:  copyExpr(field.Type),
+			})
+		}
+		return result
+	}
+
+	var result ast.Expr
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if s.pkgName != "" && !s.typeParams[t.Name] {
+			if _, typeSpec := findTypeSpec(s.files, t.Name); typeSpec != nil {
+				if !token.IsExported(t.Name) {
+					return nil, f.error(t.Pos(), &UnsupportedError{Construct: fmt.Sprintf("unexported type %s, which a fake outside package %s cannot refer to", t.Name, s.pkgName)})
+				}
+
+				importSpec := &ast.ImportSpec{
+					Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(s.pkgPath)},
+				}
+				if importName(s.pkgPath) != s.pkgName {
+					importSpec.Name = ast.NewIdent(s.pkgName)
+				}
+				f.addImport(importSpec)
+				result = &ast.SelectorExpr{X: ast.NewIdent(s.pkgName), Sel: ast.NewIdent(t.Name)}
+				break
+			}
+		}
+		result = ast.NewIdent(t.Name)
+	case *ast.SelectorExpr:
+		if pkgIdent, ok := t.X.(*ast.Ident); ok {
+			importSpec := findImportSpec(s.file, pkgIdent.Name)
+			if importSpec == nil {
+				return nil, f.error(t.Pos(), fmt.Errorf("unable to resolve package %s", pkgIdent.Name))
+			}
+			f.addImport(importSpec)
+		}
+		result = &ast.SelectorExpr{X: copyExpr(t.X), Sel: ast.NewIdent(t.Sel.Name)}
+	case *ast.IndexExpr:
+		result = &ast.IndexExpr{X: copyExpr(t.X), Index: copyExpr(t.Index)}
+	case *ast.IndexListExpr:
+		indices := make([]ast.Expr, len(t.Indices))
+		for i, index := range t.Indices {
+			indices[i] = copyExpr(index)
+		}
+		result = &ast.IndexListExpr{X: copyExpr(t.X), Indices: indices}
+	case *ast.StarExpr:
+		result = &ast.StarExpr{X: copyExpr(t.X)}
+	case *ast.ParenExpr:
+		result = &ast.ParenExpr{X: copyExpr(t.X)}
+	case *ast.Ellipsis:
+		result = &ast.Ellipsis{Elt: copyExpr(t.Elt)}
+	case *ast.ArrayType:
+		result = &ast.ArrayType{Len: copyExpr(t.Len), Elt: copyExpr(t.Elt)}
+	case *ast.MapType:
+		result = &ast.MapType{Key: copyExpr(t.Key), Value: copyExpr(t.Value)}
+	case *ast.ChanType:
+		result = &ast.ChanType{Dir: t.Dir, Value: copyExpr(t.Value)}
+	case *ast.FuncType:
+		result = &ast.FuncType{Params: copyFields(t.Params), Results: copyFields(t.Results)}
+	case *ast.StructType:
+		result = &ast.StructType{Fields: copyFields(t.Fields)}
+	case *ast.InterfaceType:
+		result = &ast.InterfaceType{Methods: copyFields(t.Methods)}
+	case *ast.BasicLit:
+		result = &ast.BasicLit{Kind: t.Kind, Value: t.Value}
+	default:
+		return nil, f.error(expr.Pos(), &UnsupportedError{Construct: describe(expr)})
+	}
+
+	return result, err
+}
+
+func findTypeSpec(files []*ast.File, name string) (*ast.File, *ast.TypeSpec) {
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				if typeSpec, ok := spec.(*ast.TypeSpec); ok && typeSpec.Name.Name == name {
+					return file, typeSpec
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func findImportSpec(file *ast.File, name string) *ast.ImportSpec {
+	for _, importSpec := range file.Imports {
+		importPath, err := strconv.Unquote(importSpec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		if importSpec.Name != nil && importSpec.Name.Name == name ||
+			importSpec.Name == nil && importName(importPath) == name {
+			return importSpec
+		}
+	}
+
+	return nil
+}
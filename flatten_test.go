@@ -0,0 +1,224 @@
+package margarine_test
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/krishicks/margarine"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Flatten", func() {
+	var (
+		fset     *token.FileSet
+		files    []*ast.File
+		file     *ast.File
+		typeSpec *ast.TypeSpec
+	)
+
+	var findTypeSpec = func(name string) {
+		for _, f := range files {
+			for _, decl := range f.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == name {
+						file = f
+						typeSpec = ts
+					}
+				}
+			}
+		}
+		Expect(typeSpec).NotTo(BeNil())
+	}
+
+	var methodNames = func(methods []*ast.Field) []string {
+		var names []string
+		for _, method := range methods {
+			names = append(names, method.Names[0].Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		fset = token.NewFileSet()
+		files = nil
+		file = nil
+		typeSpec = nil
+	})
+
+	Context("when the interface embeds interfaces from its own and other packages", func() {
+		BeforeEach(func() {
+			pkgs, err := parser.ParseDir(fset, "fixtures", nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, f := range pkgs["fixtures"].Files {
+				files = append(files, f)
+			}
+
+			findTypeSpec("Simple")
+		})
+
+		It("includes the methods of the embedded interfaces", func() {
+			methods, _, err := margarine.Flatten(fset, files, file, typeSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(methodNames(methods)).To(Equal([]string{"A", "Write", "EmbeddedA"}))
+		})
+	})
+
+	Context("when a method is embedded more than once", func() {
+		BeforeEach(func() {
+			src := []byte(`
+package mypackage
+
+import (
+	"io"
+	"io/fs"
+)
+
+type MyInterface interface {
+	io.ReadWriter
+	io.Reader
+	fs.FS
+	error
+}
+`)
+
+			f, err := parser.ParseFile(fset, "src.go", src, 0)
+			Expect(err).NotTo(HaveOccurred())
+			files = []*ast.File{f}
+
+			findTypeSpec("MyInterface")
+		})
+
+		It("includes the method once", func() {
+			methods, _, err := margarine.Flatten(fset, files, file, typeSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(methodNames(methods)).To(Equal([]string{"Read", "Write", "Open", "Error"}))
+		})
+
+		It("qualifies types declared in the embedded interface's package", func() {
+			// Open(name string) (fs.File, error)
+			methods, imports, err := margarine.Flatten(fset, files, file, typeSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			funcType, ok := methods[2].Type.(*ast.FuncType)
+			Expect(ok).To(BeTrue())
+
+			Expect(funcType.Results.List[0].Type).To(Equal(&ast.SelectorExpr{
+				X:   ast.NewIdent("fs"),
+				Sel: ast.NewIdent("File"),
+			}))
+
+			var paths []string
+			for _, importSpec := range imports {
+				paths = append(paths, importSpec.Path.Value)
+			}
+			Expect(paths).To(ConsistOf(`"io"`, `"io/fs"`))
+		})
+	})
+
+	Context("when an embedded interface cannot be found", func() {
+		BeforeEach(func() {
+			src := []byte(`
+package mypackage
+
+type MyInterface interface {
+	Missing
+}
+`)
+
+			f, err := parser.ParseFile(fset, "src.go", src, 0)
+			Expect(err).NotTo(HaveOccurred())
+			files = []*ast.File{f}
+
+			findTypeSpec("MyInterface")
+		})
+
+		It("returns an error with the position of the embed", func() {
+			_, _, err := margarine.Flatten(fset, files, file, typeSpec)
+			Expect(err).To(MatchError("src.go:5:2: MyInterface: unable to find embedded interface Missing"))
+		})
+	})
+
+	Context("when the interface is a constraint with a type union", func() {
+		BeforeEach(func() {
+			src := []byte(`
+package mypackage
+
+type MyInterface interface {
+	~int | string
+}
+`)
+
+			f, err := parser.ParseFile(fset, "src.go", src, 0)
+			Expect(err).NotTo(HaveOccurred())
+			files = []*ast.File{f}
+
+			findTypeSpec("MyInterface")
+		})
+
+		It("returns an UnsupportedError", func() {
+			_, _, err := margarine.Flatten(fset, files, file, typeSpec)
+
+			var unsupportedErr *margarine.UnsupportedError
+			Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+			Expect(unsupportedErr.Construct).To(Equal("type union"))
+
+			var flattenErr *margarine.Error
+			Expect(errors.As(err, &flattenErr)).To(BeTrue())
+			Expect(flattenErr.Interface).To(Equal("MyInterface"))
+			Expect(flattenErr.Pos.Line).To(Equal(5))
+		})
+	})
+
+	Context("when the type is not an interface", func() {
+		BeforeEach(func() {
+			src := []byte(`
+package mypackage
+
+type MyInterface struct{}
+`)
+
+			f, err := parser.ParseFile(fset, "src.go", src, 0)
+			Expect(err).NotTo(HaveOccurred())
+			files = []*ast.File{f}
+
+			findTypeSpec("MyInterface")
+		})
+
+		It("returns a NotAnInterfaceError", func() {
+			_, _, err := margarine.Flatten(fset, files, file, typeSpec)
+
+			var notAnInterfaceErr *margarine.NotAnInterfaceError
+			Expect(errors.As(err, &notAnInterfaceErr)).To(BeTrue())
+			Expect(notAnInterfaceErr.Name).To(Equal("MyInterface"))
+		})
+	})
+})
+
+var _ = Describe("StructFromMethods", func() {
+	It("returns the input expected by Fakify", func() {
+		methods := []*ast.Field{{
+			Names: []*ast.Ident{ast.NewIdent("Method")},
+			Type:  &ast.FuncType{Params: &ast.FieldList{}},
+		}}
+
+		genDecl, funcDecls := margarine.StructFromMethods("MyStruct", nil, methods)
+
+		Expect(genDecl.Specs).To(HaveLen(1))
+		typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec)
+		Expect(ok).To(BeTrue())
+		Expect(typeSpec.Name.Name).To(Equal("MyStruct"))
+
+		Expect(funcDecls).To(HaveLen(1))
+		Expect(funcDecls[0].Name.Name).To(Equal("Method"))
+	})
+})
@@ -0,0 +1,254 @@
+package margarine
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GenerateOpts controls the fake that Generate produces.
+type GenerateOpts struct {
+	FakifyOpts
+
+	// PackageName is the name of the fake's package. When it is empty or the
+	// name of the interface's package, the fake is generated alongside the
+	// interface.
+	PackageName string
+
+	// ImportPath is the import path of the interface's package, which a fake
+	// in another package imports to refer to the interface and the types it
+	// uses.
+	ImportPath string
+}
+
+// Generate returns the formatted source of a file containing a fake of the
+// interface declared by typeSpec in file, along with the imports it needs and
+// an assertion that it implements the interface. files are all of the files
+// in the interface's package, which is where embedded interfaces are looked
+// up.
+func Generate(fset *token.FileSet, files []*ast.File, file *ast.File, typeSpec *ast.TypeSpec, opts GenerateOpts) ([]byte, error) {
+	f := &flattener{
+		fset:  fset,
+		iface: typeSpec.Name.Name,
+		seen:  map[string]bool{},
+	}
+
+	iface, ok := typeSpec.Type.(*ast.InterfaceType)
+	if !ok {
+		return nil, f.error(typeSpec.Pos(), &NotAnInterfaceError{Name: typeSpec.Name.Name})
+	}
+
+	pkgName := file.Name.Name
+	if opts.PackageName == "" {
+		opts.PackageName = pkgName
+	}
+
+	// a fake in another package refers to the types of the interface's
+	// package the same way it refers to those of any other package
+	s := &scope{files: files, file: file, typeParams: map[string]bool{}}
+	if typeSpec.TypeParams != nil {
+		for _, field := range typeSpec.TypeParams.List {
+			for _, name := range field.Names {
+				s.typeParams[name.Name] = true
+			}
+		}
+	}
+	if opts.PackageName != pkgName {
+		if opts.ImportPath == "" {
+			return nil, f.error(typeSpec.Pos(), fmt.Errorf("import path of package %s is needed to fake it in package %s", pkgName, opts.PackageName))
+		}
+		// _test.go files are only compiled into their own package's tests
+		if strings.HasSuffix(fset.Position(file.Package).Filename, "_test.go") {
+			return nil, f.error(typeSpec.Pos(), fmt.Errorf("declared in a _test.go file, which package %s cannot import", opts.PackageName))
+		}
+		s.pkgName = pkgName
+		s.pkgPath = opts.ImportPath
+	}
+
+	err := f.flatten(s, iface)
+	if err != nil {
+		return nil, err
+	}
+
+	var typeParams *ast.FieldList
+	if typeSpec.TypeParams != nil {
+		typeParams = &ast.FieldList{}
+		for _, field := range typeSpec.TypeParams.List {
+			constraint, err := f.copyType(s, field.Type)
+			if err != nil {
+				return nil, err
+			}
+
+			var names []*ast.Ident
+			for _, name := range field.Names {
+				names = append(names, ast.NewIdent(name.Name))
+			}
+
+			typeParams.List = append(typeParams.List, &ast.Field{Names: names, Type: constraint})
+		}
+	}
+
+	genDecl, funcDecls := StructFromMethods(typeSpec.Name.Name, typeParams, f.methods)
+
+	err = FakifyWithOpts(fset, genDecl, &funcDecls, opts.FakifyOpts)
+	if err != nil {
+		// the struct is built rather than parsed, so point at the interface
+		var fakifyErr *Error
+		if errors.As(err, &fakifyErr) && !fakifyErr.Pos.IsValid() {
+			fakifyErr.Pos = fset.Position(typeSpec.Pos())
+		}
+		return nil, err
+	}
+
+	// the imports of the embedded interfaces are resolved alongside those of
+	// the file the interface was declared in
+	importsFile := &ast.File{
+		Imports: append(append([]*ast.ImportSpec{}, file.Imports...), f.imports...),
+	}
+
+	importDecl, err := Imports(importsFile, genDecl, funcDecls)
+	if err != nil {
+		return nil, f.error(typeSpec.Pos(), err)
+	}
+
+	decls := []ast.Decl{importDecl, genDecl}
+	for _, funcDecl := range funcDecls {
+		decls = append(decls, funcDecl)
+	}
+
+	// generic interfaces can't be asserted without instantiating them
+	if typeParams == nil {
+		fakeName := genDecl.Specs[0].(*ast.TypeSpec).Name.Name
+		if opts.PackageName == pkgName {
+			decls = append(decls, InterfaceAssertion(fakeName, "", typeSpec.Name.Name))
+		} else {
+			// the fake only imports another package of the same name when
+			// its methods don't use the interface's package, so the
+			// interface's package can be renamed
+			name := pkgName
+			for n := 2; ; n++ {
+				importPath := importedPath(importDecl, name)
+				if importPath == "" || importPath == opts.ImportPath {
+					break
+				}
+				name = pkgName + strconv.Itoa(n)
+			}
+
+			importSpec := &ast.ImportSpec{
+				Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(opts.ImportPath)},
+			}
+			if importName(opts.ImportPath) != name {
+				importSpec.Name = ast.NewIdent(name)
+			}
+			addImportSpec(importDecl, importSpec)
+
+			decls = append(decls, InterfaceAssertion(fakeName, name, typeSpec.Name.Name))
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by margarine. DO NOT EDIT.\n\npackage %s\n", opts.PackageName)
+
+	// each declaration is printed on its own so that they are separated by
+	// blank lines, which the printer only adds between positioned nodes
+	for _, decl := range decls {
+		buf.WriteString("\n")
+		err := format.Node(&buf, token.NewFileSet(), decl)
+		if err != nil {
+			return nil, f.error(typeSpec.Pos(), err)
+		}
+		buf.WriteString("\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, f.error(typeSpec.Pos(), err)
+	}
+
+	return src, nil
+}
+
+// Fake is a fake generated by GenerateAll.
+type Fake struct {
+	Interface string
+	Src       []byte
+}
+
+// GenerateAll generates a fake of each exported interface declared in dir,
+// in the order of their names. opts apply to every fake, other than
+// StructName, which is ignored so that each fake is named after its
+// interface. Interfaces that cannot be faked, such as constraints with type
+// unions, are skipped, and the errors saying why are returned in skipped.
+func GenerateAll(fset *token.FileSet, dir string, findOpts FindOpts, opts GenerateOpts) (fakes []Fake, skipped []error, err error) {
+	files, err := parseDir(fset, dir, findOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts.StructName = ""
+
+	for _, name := range interfaceNames(files) {
+		if !token.IsExported(name) {
+			continue
+		}
+
+		found, err := findInterface(fset, dir, files, name)
+		if err != nil {
+			skipped = append(skipped, err)
+			continue
+		}
+
+		src, err := Generate(fset, found.Files, found.File, found.TypeSpec, opts)
+		if err != nil {
+			skipped = append(skipped, err)
+			continue
+		}
+
+		fakes = append(fakes, Fake{Interface: name, Src: src})
+	}
+
+	return fakes, skipped, nil
+}
+
+// importedPath returns the path of the package importDecl imports as name,
+// or "" when there isn't one.
+func importedPath(importDecl *ast.GenDecl, name string) string {
+	for _, spec := range importDecl.Specs {
+		importSpec := spec.(*ast.ImportSpec)
+
+		importPath, err := strconv.Unquote(importSpec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		if importSpec.Name != nil && importSpec.Name.Name == name ||
+			importSpec.Name == nil && importName(importPath) == name {
+			return importPath
+		}
+	}
+
+	return ""
+}
+
+func addImportSpec(importDecl *ast.GenDecl, importSpec *ast.ImportSpec) {
+	for _, spec := range importDecl.Specs {
+		if spec.(*ast.ImportSpec).Path.Value == importSpec.Path.Value {
+			return
+		}
+	}
+
+	importDecl.Specs = append(importDecl.Specs, importSpec)
+	sort.Slice(importDecl.Specs, func(i, j int) bool {
+		return importDecl.Specs[i].(*ast.ImportSpec).Path.Value < importDecl.Specs[j].(*ast.ImportSpec).Path.Value
+	})
+
+	if len(importDecl.Specs) > 1 {
+		importDecl.Lparen = 1
+	}
+}
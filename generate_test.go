@@ -0,0 +1,395 @@
+package margarine_test
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+
+	"github.com/krishicks/margarine"
+	"github.com/krishicks/margarine/internal/testfiles"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Generate", func() {
+	var (
+		fset     *token.FileSet
+		files    []*ast.File
+		file     *ast.File
+		typeSpec *ast.TypeSpec
+	)
+
+	var parseSrc = func(src string, name string) {
+		f, err := parser.ParseFile(fset, "src.go", src, 0)
+		Expect(err).NotTo(HaveOccurred())
+		files = []*ast.File{f}
+		file = f
+
+		for _, decl := range f.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == name {
+					typeSpec = ts
+				}
+			}
+		}
+		Expect(typeSpec).NotTo(BeNil())
+	}
+
+	BeforeEach(func() {
+		fset = token.NewFileSet()
+		files = nil
+		file = nil
+		typeSpec = nil
+	})
+
+	Context("when the fake is in the interface's package", func() {
+		BeforeEach(func() {
+			parseSrc(`
+package mypackage
+
+import "io"
+
+type MyInterface interface {
+	Method(w io.Writer) (Thing, error)
+}
+
+type Thing struct{}
+`, "MyInterface")
+		})
+
+		It("returns a formatted file with the fake and an assertion", func() {
+			src, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			output := string(src)
+			Expect(output).To(HavePrefix("// Code generated by margarine. DO NOT EDIT.\n\npackage mypackage\n\nimport (\n\t\"io\"\n\t\"sync\"\n)\n\ntype (\n"))
+			Expect(output).To(MatchRegexp(`\tMethodStub +func\(io\.Writer\) \(Thing, error\)\n`))
+			Expect(output).To(HaveSuffix("\nvar _ MyInterface = new(FakeMyInterface)\n"))
+		})
+	})
+
+	Context("when the fake is in another package", func() {
+		BeforeEach(func() {
+			parseSrc(`
+package mypackage
+
+import "io"
+
+type MyInterface interface {
+	Method(w io.Writer) (Thing, error)
+}
+
+type Thing struct{}
+`, "MyInterface")
+		})
+
+		It("qualifies the interface's types and imports its package", func() {
+			src, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{
+				PackageName: "mypackagefakes",
+				ImportPath:  "example.com/mypackage",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			output := string(src)
+			Expect(output).To(HavePrefix("// Code generated by margarine. DO NOT EDIT.\n\npackage mypackagefakes\n\nimport (\n\t\"example.com/mypackage\"\n\t\"io\"\n\t\"sync\"\n)\n"))
+			Expect(output).To(MatchRegexp(`\tMethodStub +func\(io\.Writer\) \(mypackage\.Thing, error\)\n`))
+			Expect(output).To(HaveSuffix("\nvar _ mypackage.MyInterface = new(FakeMyInterface)\n"))
+		})
+
+		It("names the import when the package name differs from the last element of its path", func() {
+			src, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{
+				PackageName: "mypackagefakes",
+				ImportPath:  "example.com/my-package",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(src)).To(ContainSubstring("\tmypackage \"example.com/my-package\"\n"))
+		})
+
+		It("returns an error without the import path of the interface's package", func() {
+			_, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{
+				PackageName: "mypackagefakes",
+			})
+			Expect(err).To(MatchError("src.go:6:6: MyInterface: import path of package mypackage is needed to fake it in package mypackagefakes"))
+		})
+	})
+
+	Context("when the interface's package has the name of a package the fake uses", func() {
+		It("renames the package the fake uses when the methods use the interface's package", func() {
+			parseSrc(`
+package sync
+
+type Locker interface {
+	Lock() Thing
+}
+
+type Thing struct{}
+`, "Locker")
+
+			src, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{
+				PackageName: "syncfakes",
+				ImportPath:  "example.com/sync",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			output := string(src)
+			Expect(output).To(ContainSubstring("import (\n\t\"example.com/sync\"\n\tgosync \"sync\"\n)\n"))
+			Expect(output).To(MatchRegexp(`\tlockMutex +gosync\.RWMutex\n`))
+			Expect(output).To(HaveSuffix("\nvar _ sync.Locker = new(FakeLocker)\n"))
+		})
+
+		It("renames the interface's package when only the assertion uses it", func() {
+			parseSrc(`
+package sync
+
+type Locker interface {
+	Lock()
+}
+`, "Locker")
+
+			src, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{
+				PackageName: "syncfakes",
+				ImportPath:  "example.com/sync",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			output := string(src)
+			Expect(output).To(ContainSubstring("import (\n\tsync2 \"example.com/sync\"\n\t\"sync\"\n)\n"))
+			Expect(output).To(MatchRegexp(`\tlockMutex +sync\.RWMutex\n`))
+			Expect(output).To(HaveSuffix("\nvar _ sync2.Locker = new(FakeLocker)\n"))
+		})
+	})
+
+	Context("when the interface is generic", func() {
+		BeforeEach(func() {
+			parseSrc(`
+package mypackage
+
+type MyInterface[K comparable, V any] interface {
+	Get(key K) V
+}
+`, "MyInterface")
+		})
+
+		It("leaves out the assertion", func() {
+			src, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			output := string(src)
+			Expect(output).To(ContainSubstring("\tFakeMyInterface[K comparable, V any] struct {\n"))
+			Expect(output).NotTo(ContainSubstring("var _"))
+		})
+	})
+
+	Context("when the interface is generic and faked in another package", func() {
+		BeforeEach(func() {
+			parseSrc(`
+package mypackage
+
+import "fmt"
+
+type T struct{}
+
+type MyInterface[T any, K fmt.Stringer] interface {
+	Get(key K) (T, bool)
+	Thing() Thing
+}
+
+type Thing struct{}
+`, "MyInterface")
+		})
+
+		It("qualifies the package's types, but not type parameters of the same names", func() {
+			src, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{
+				PackageName: "mypackagefakes",
+				ImportPath:  "example.com/mypackage",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			output := string(src)
+			Expect(output).To(ContainSubstring("\tFakeMyInterface[T any, K fmt.Stringer] struct {\n"))
+			Expect(output).To(MatchRegexp(`\tGetStub +func\(K\) \(T, bool\)\n`))
+			Expect(output).To(MatchRegexp(`\tThingStub +func\(\) mypackage\.Thing\n`))
+		})
+
+		It("imports the packages of the constraints", func() {
+			src, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{
+				PackageName: "mypackagefakes",
+				ImportPath:  "example.com/mypackage",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(string(src)).To(ContainSubstring("import (\n\t\"example.com/mypackage\"\n\t\"fmt\"\n\t\"sync\"\n)\n"))
+		})
+	})
+
+	Context("when the type is not an interface", func() {
+		BeforeEach(func() {
+			parseSrc(`
+package mypackage
+
+type MyInterface struct{}
+`, "MyInterface")
+		})
+
+		It("returns a NotAnInterfaceError", func() {
+			_, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{})
+
+			var notAnInterfaceErr *margarine.NotAnInterfaceError
+			Expect(err).To(BeAssignableToTypeOf(&margarine.Error{}))
+			Expect(errors.As(err, &notAnInterfaceErr)).To(BeTrue())
+		})
+	})
+
+	Context("when the interface has a method the fake declares too", func() {
+		BeforeEach(func() {
+			parseSrc(`
+package mypackage
+
+import "hash"
+
+type MyInterface interface {
+	hash.Hash
+}
+`, "MyInterface")
+		})
+
+		It("implements the interface's method in place of the fake's", func() {
+			src, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			output := string(src)
+			Expect(output).To(ContainSubstring("\tResetStub "))
+			Expect(output).To(ContainSubstring("\nfunc (fake *FakeMyInterface) Reset() {\n\tfake.resetMutex.Lock()\n"))
+			Expect(output).To(ContainSubstring("\nfunc (fake *FakeMyInterface) ResetStubs() {\n"))
+		})
+	})
+
+	Context("when the interface has methods the fake declares methods of the same name for", func() {
+		BeforeEach(func() {
+			parseSrc(`
+package mypackage
+
+type MyInterface interface {
+	Foo()
+	FooCalls()
+}
+`, "MyInterface")
+		})
+
+		It("returns an UnsupportedError with the position of the interface", func() {
+			_, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{})
+			Expect(err).To(MatchError("src.go:4:6: MyInterface: unsupported method FooCalls, which clashes with the fake's method FooCalls for Foo"))
+
+			var unsupportedErr *margarine.UnsupportedError
+			Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+		})
+	})
+
+	Context("when the interface is declared in a _test.go file and faked in another package", func() {
+		BeforeEach(func() {
+			f, err := parser.ParseFile(fset, "src_test.go", "package mypackage\n\ntype MyInterface interface{}\n", 0)
+			Expect(err).NotTo(HaveOccurred())
+			files = []*ast.File{f}
+			file = f
+			typeSpec = f.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+		})
+
+		It("returns an error", func() {
+			_, err := margarine.Generate(fset, files, file, typeSpec, margarine.GenerateOpts{
+				PackageName: "mypackagefakes",
+				ImportPath:  "example.com/mypackage",
+			})
+			Expect(err).To(MatchError("src_test.go:3:6: MyInterface: declared in a _test.go file, which package mypackagefakes cannot import"))
+		})
+	})
+})
+
+var _ = Describe("GenerateAll", func() {
+	var (
+		fset *token.FileSet
+		dir  string
+	)
+
+	BeforeEach(func() {
+		fset = token.NewFileSet()
+
+		dir = testfiles.TempDir()
+
+		testfiles.WriteFile(dir, "a.go", `package mypackage
+
+type Reader interface {
+	Read() string
+}
+
+type Number interface {
+	~int | ~float64
+}
+
+type unexported interface {
+	Method()
+}
+
+type Private interface {
+	private()
+}
+
+type UsesUnexported interface {
+	Get() thing
+}
+
+type thing struct{}
+`)
+		testfiles.WriteFile(dir, "b.go", `package mypackage
+
+type Writer interface {
+	Write(s string)
+}
+`)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("generates a fake of each exported interface, skipping those that cannot be faked", func() {
+		fakes, skipped, err := margarine.GenerateAll(fset, dir, margarine.FindOpts{}, margarine.GenerateOpts{
+			FakifyOpts:  margarine.FakifyOpts{StructName: "Ignored"},
+			PackageName: "mypackagefakes",
+			ImportPath:  "example.com/mypackage",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakes).To(HaveLen(2))
+		Expect(fakes[0].Interface).To(Equal("Reader"))
+		Expect(string(fakes[0].Src)).To(ContainSubstring("var _ mypackage.Reader = new(FakeReader)"))
+		Expect(fakes[1].Interface).To(Equal("Writer"))
+		Expect(string(fakes[1].Src)).To(ContainSubstring("var _ mypackage.Writer = new(FakeWriter)"))
+
+		Expect(skipped).To(HaveLen(3))
+		for _, err := range skipped {
+			var unsupportedErr *margarine.UnsupportedError
+			Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+		}
+		Expect(skipped[0]).To(MatchError(ContainSubstring("Number")))
+		Expect(skipped[1]).To(MatchError(ContainSubstring("Private: unsupported unexported method private, which a fake outside package mypackage cannot implement")))
+		Expect(skipped[2]).To(MatchError(ContainSubstring("UsesUnexported: unsupported unexported type thing, which a fake outside package mypackage cannot refer to")))
+	})
+
+	It("fakes interfaces with unexported methods and types in their own package", func() {
+		fakes, skipped, err := margarine.GenerateAll(fset, dir, margarine.FindOpts{}, margarine.GenerateOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		var names []string
+		for _, fake := range fakes {
+			names = append(names, fake.Interface)
+		}
+		Expect(names).To(Equal([]string{"Private", "Reader", "UsesUnexported", "Writer"}))
+		Expect(skipped).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,37 @@
+package margarine_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// findFuncDecl returns the declaration of the method named name in
+// funcDecls, or nil when there isn't one.
+func findFuncDecl(funcDecls []*ast.FuncDecl, name string) *ast.FuncDecl {
+	for _, funcDecl := range funcDecls {
+		if funcDecl.Name.Name == name {
+			return funcDecl
+		}
+	}
+	return nil
+}
+
+// printFuncDecl returns the source of the method named name in funcDecls,
+// failing the spec when there isn't one.
+func printFuncDecl(funcDecls []*ast.FuncDecl, name string) string {
+	funcDecl := findFuncDecl(funcDecls, name)
+	if funcDecl == nil {
+		Fail(name + " not found")
+	}
+
+	var buf bytes.Buffer
+	err := format.Node(&buf, token.NewFileSet(), funcDecl)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	return buf.String()
+}
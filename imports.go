@@ -0,0 +1,247 @@
+package margarine
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Imports returns the import declaration needed by a fake produced by Fakify.
+// Each package identifier used in the fake's field and method types, and in
+// its method bodies, is resolved to an import path using the imports of file,
+// the source file the interface was declared in, other than those of the
+// packages the fake uses itself, such as sync, which are always resolved to
+// the standard library.
+func Imports(file *ast.File, genDecl *ast.GenDecl, funcDecls []*ast.FuncDecl) (*ast.GenDecl, error) {
+	known := map[string]*ast.ImportSpec{}
+
+	for _, importSpec := range file.Imports {
+		importPath, err := strconv.Unquote(importSpec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		name := importName(importPath)
+		if importSpec.Name != nil {
+			name = importSpec.Name.Name
+		}
+
+		if name == "_" || name == "." {
+			continue
+		}
+
+		known[name] = importSpec
+	}
+
+	// the names the fake refers to its own packages by are not used by the
+	// interface, so they take the place of any imports of file of the same
+	// names
+	var typeParams *ast.FieldList
+	if len(genDecl.Specs) > 0 {
+		if typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec); ok {
+			typeParams = typeSpec.TypeParams
+		}
+	}
+	for importPath, name := range ownPackageNames(typeParams, funcDecls) {
+		importSpec := &ast.ImportSpec{
+			Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(importPath)},
+		}
+		if name != importPath {
+			importSpec.Name = ast.NewIdent(name)
+		}
+		known[name] = importSpec
+	}
+
+	used := map[string]bool{}
+	// locals are the names declared in the method being walked, which are
+	// not package identifiers
+	var walk = func(node ast.Node, locals map[string]bool) {
+		ast.Inspect(node, func(n ast.Node) bool {
+			if selectorExpr, ok := n.(*ast.SelectorExpr); ok {
+				if ident, ok := selectorExpr.X.(*ast.Ident); ok && !locals[ident.Name] {
+					used[ident.Name] = true
+				}
+			}
+			return true
+		})
+	}
+
+	for _, spec := range genDecl.Specs {
+		if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+			if typeSpec.TypeParams != nil {
+				walk(typeSpec.TypeParams, nil)
+			}
+			walk(typeSpec.Type, nil)
+		}
+	}
+
+	for _, funcDecl := range funcDecls {
+		walk(funcDecl.Type, nil)
+		if funcDecl.Body != nil {
+			walk(funcDecl.Body, localNames(funcDecl))
+		}
+	}
+
+	var names []string
+	for name := range used {
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("unable to resolve package %s", name)
+		}
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		return known[names[i]].Path.Value < known[names[j]].Path.Value
+	})
+
+	importDecl := &ast.GenDecl{
+		Tok: token.IMPORT,
+	}
+
+	for _, name := range names {
+		importSpec := &ast.ImportSpec{
+			Path: &ast.BasicLit{
+				Kind:  token.STRING,
+				Value: known[name].Path.Value,
+			},
+		}
+
+		if known[name].Name != nil {
+			importSpec.Name = ast.NewIdent(known[name].Name.Name)
+		}
+
+		importDecl.Specs = append(importDecl.Specs, importSpec)
+	}
+
+	if len(importDecl.Specs) > 1 {
+		// the printer only groups specs in parens when Lparen is valid
+		importDecl.Lparen = 1
+	}
+
+	return importDecl, nil
+}
+
+// ownPackages are the packages that the code Fakify generates uses.
+var ownPackages = []string{"fmt", "reflect", "sync"}
+
+// ownPackageNames returns the names a fake refers to ownPackages by, keyed
+// by their import paths. Each is named after its path, unless that is the
+// name of a package used by the interface, as it is when the interface
+// refers to a package of its own named sync, when it is prefixed with go, as
+// in gosync.
+func ownPackageNames(typeParams *ast.FieldList, funcDecls []*ast.FuncDecl) map[string]string {
+	used := signaturePackages(typeParams, funcDecls)
+
+	names := map[string]string{}
+	for _, importPath := range ownPackages {
+		name := importPath
+		if used[name] {
+			name = "go" + importPath
+		}
+		for n := 2; used[name]; n++ {
+			name = "go" + importPath + strconv.Itoa(n)
+		}
+		names[importPath] = name
+	}
+
+	return names
+}
+
+// signaturePackages returns the names of the packages used by the type
+// parameters and the signatures of funcDecls, which are those of the
+// interface: the methods Fakify adds only use types from the signatures of
+// the interface's methods.
+func signaturePackages(typeParams *ast.FieldList, funcDecls []*ast.FuncDecl) map[string]bool {
+	used := map[string]bool{}
+
+	var walk = func(node ast.Node) {
+		ast.Inspect(node, func(n ast.Node) bool {
+			if selectorExpr, ok := n.(*ast.SelectorExpr); ok {
+				if ident, ok := selectorExpr.X.(*ast.Ident); ok {
+					used[ident.Name] = true
+				}
+			}
+			return true
+		})
+	}
+
+	if typeParams != nil {
+		walk(typeParams)
+	}
+	for _, funcDecl := range funcDecls {
+		walk(funcDecl.Type)
+	}
+
+	return used
+}
+
+func localNames(funcDecl *ast.FuncDecl) map[string]bool {
+	locals := map[string]bool{}
+
+	var addFields = func(fl *ast.FieldList) {
+		if fl == nil {
+			return
+		}
+		for _, field := range fl.List {
+			for _, name := range field.Names {
+				locals[name.Name] = true
+			}
+		}
+	}
+
+	addFields(funcDecl.Recv)
+	addFields(funcDecl.Type.Params)
+	addFields(funcDecl.Type.Results)
+
+	var addIdent = func(expr ast.Expr) {
+		if ident, ok := expr.(*ast.Ident); ok {
+			locals[ident.Name] = true
+		}
+	}
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				for _, lhs := range n.Lhs {
+					addIdent(lhs)
+				}
+			}
+		case *ast.RangeStmt:
+			addIdent(n.Key)
+			addIdent(n.Value)
+		case *ast.ValueSpec:
+			for _, name := range n.Names {
+				locals[name.Name] = true
+			}
+		case *ast.FuncLit:
+			addFields(n.Type.Params)
+		}
+		return true
+	})
+
+	return locals
+}
+
+var majorVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// importName guesses the name of the package at importPath, which is usually,
+// but not necessarily, the last element of the path.
+func importName(importPath string) string {
+	name := path.Base(importPath)
+	if majorVersion.MatchString(name) && path.Dir(importPath) != "." {
+		name = path.Base(path.Dir(importPath))
+	}
+
+	// gopkg.in/yaml.v2
+	if i := strings.Index(name, ".v"); i > 0 {
+		name = name[:i]
+	}
+
+	return strings.TrimPrefix(name, "go-")
+}
@@ -0,0 +1,199 @@
+package margarine_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/krishicks/margarine"
+	"github.com/krishicks/patrick"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Imports", func() {
+	var (
+		src       []byte
+		file      *ast.File
+		genDecl   *ast.GenDecl
+		funcDecls []*ast.FuncDecl
+	)
+
+	BeforeEach(func() {
+		src = []byte(`
+package mypackage
+
+import (
+	ctx "context"
+	"io"
+	"net/http"
+	"os"
+)
+
+type MyInterface interface {
+	Method(ctx.Context, io.Reader) os.Signal
+}
+`)
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		file, err = parser.ParseFile(token.NewFileSet(), "src.go", src, parser.ImportsOnly)
+		Expect(err).NotTo(HaveOccurred())
+
+		genDecl, funcDecls, err = patrick.Pour(src, "MyInterface", "MyStruct")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = margarine.Fakify(token.NewFileSet(), genDecl, &funcDecls)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns an import for each package used by the fake", func() {
+		importDecl, err := margarine.Imports(file, genDecl, funcDecls)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(importDecl.Tok).To(Equal(token.IMPORT))
+
+		var paths []string
+		for _, spec := range importDecl.Specs {
+			importSpec, ok := spec.(*ast.ImportSpec)
+			Expect(ok).To(BeTrue())
+			paths = append(paths, importSpec.Path.Value)
+		}
+
+		Expect(paths).To(Equal([]string{`"context"`, `"io"`, `"os"`, `"sync"`}))
+	})
+
+	It("preserves the name of an aliased import", func() {
+		importDecl, err := margarine.Imports(file, genDecl, funcDecls)
+		Expect(err).NotTo(HaveOccurred())
+
+		importSpec, ok := importDecl.Specs[0].(*ast.ImportSpec)
+		Expect(ok).To(BeTrue())
+
+		Expect(importSpec.Name).NotTo(BeNil())
+		Expect(importSpec.Name.Name).To(Equal("ctx"))
+	})
+
+	Context("when the fake uses a package in a method body", func() {
+		JustBeforeEach(func() {
+			var err error
+			genDecl, funcDecls, err = patrick.Pour(src, "MyInterface", "MyStruct")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = margarine.FakifyWithOpts(token.NewFileSet(), genDecl, &funcDecls, margarine.FakifyOpts{Strict: true})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an import for it", func() {
+			importDecl, err := margarine.Imports(file, genDecl, funcDecls)
+			Expect(err).NotTo(HaveOccurred())
+
+			var paths []string
+			for _, spec := range importDecl.Specs {
+				importSpec, ok := spec.(*ast.ImportSpec)
+				Expect(ok).To(BeTrue())
+				paths = append(paths, importSpec.Path.Value)
+			}
+
+			Expect(paths).To(Equal([]string{`"context"`, `"fmt"`, `"io"`, `"os"`, `"sync"`}))
+		})
+	})
+
+	Context("when the file imports another package with the name of one the fake uses", func() {
+		BeforeEach(func() {
+			src = []byte(`
+package mypackage
+
+import "example.com/other/sync"
+
+type MyInterface interface {
+	Method() sync.Thing
+}
+`)
+		})
+
+		It("imports the fake's package under another name", func() {
+			importDecl, err := margarine.Imports(file, genDecl, funcDecls)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(importDecl.Specs).To(Equal([]ast.Spec{
+				&ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: `"example.com/other/sync"`}},
+				&ast.ImportSpec{Name: ast.NewIdent("gosync"), Path: &ast.BasicLit{Kind: token.STRING, Value: `"sync"`}},
+			}))
+
+			typeSpec := genDecl.Specs[0].(*ast.TypeSpec)
+			field := typeSpec.Type.(*ast.StructType).Fields.List[1]
+			Expect(field.Names[0].Name).To(Equal("methodMutex"))
+			Expect(field.Type).To(Equal(&ast.SelectorExpr{X: ast.NewIdent("gosync"), Sel: ast.NewIdent("RWMutex")}))
+		})
+	})
+
+	Context("when the file imports another package with the name of one the fake uses, but the interface does not use it", func() {
+		BeforeEach(func() {
+			src = []byte(`
+package mypackage
+
+import "example.com/other/sync"
+
+type MyInterface interface {
+	Method()
+}
+
+var _ sync.Thing
+`)
+		})
+
+		It("imports the fake's package", func() {
+			importDecl, err := margarine.Imports(file, genDecl, funcDecls)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(importDecl.Specs).To(Equal([]ast.Spec{
+				&ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: `"sync"`}},
+			}))
+		})
+	})
+
+	Context("when a package is imported with a versioned path", func() {
+		BeforeEach(func() {
+			src = []byte(`
+package mypackage
+
+import "gopkg.in/yaml.v2"
+
+type MyInterface interface {
+	Method() yaml.MapSlice
+}
+`)
+		})
+
+		It("resolves the package by its name", func() {
+			importDecl, err := margarine.Imports(file, genDecl, funcDecls)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(importDecl.Specs).To(HaveLen(2))
+
+			importSpec, ok := importDecl.Specs[0].(*ast.ImportSpec)
+			Expect(ok).To(BeTrue())
+			Expect(importSpec.Path.Value).To(Equal(`"gopkg.in/yaml.v2"`))
+			Expect(importSpec.Name).To(BeNil())
+		})
+	})
+
+	Context("when a package is not imported by the file", func() {
+		BeforeEach(func() {
+			src = []byte(`
+package mypackage
+
+type MyInterface interface {
+	Method() os.Signal
+}
+`)
+		})
+
+		It("returns an error", func() {
+			_, err := margarine.Imports(file, genDecl, funcDecls)
+			Expect(err).To(MatchError("unable to resolve package os"))
+		})
+	})
+})
@@ -0,0 +1,35 @@
+// Package testfiles writes the source files that the tests of margarine and
+// its CLI work with to temporary directories.
+package testfiles
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/gomega"
+)
+
+// TempDir makes a temporary directory for a spec, which the spec removes.
+// Symlinks in its path, such as those of the temporary directory on macOS,
+// are resolved so that it matches the paths margarine reports.
+func TempDir() string {
+	dir, err := os.MkdirTemp("", "margarine")
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	dir, err = filepath.EvalSymlinks(dir)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	return dir
+}
+
+// WriteFile writes src to the file name in dir, making the directories
+// name is in.
+func WriteFile(dir string, name string, src string) {
+	path := filepath.Join(dir, name)
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	err = os.WriteFile(path, []byte(src), 0644)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+}
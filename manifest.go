@@ -0,0 +1,99 @@
+package margarine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"os"
+)
+
+// ManifestFile is the name of the manifest at the root of a module.
+const ManifestFile = "margarine.json"
+
+// Manifest lists the fakes of a module, so they can all be generated at
+// once:
+//
+//	{
+//	  "fakes": [
+//	    {
+//	      "package": "example.com/service/store",
+//	      "interface": "Store",
+//	      "outDir": "store/storefakes",
+//	      "name": "FakeStore",
+//	      "packageName": "storefakes",
+//	      "strict": true
+//	    }
+//	  ]
+//	}
+type Manifest struct {
+	Fakes []ManifestFake `json:"fakes"`
+}
+
+// ManifestFake is a fake listed in a Manifest. Package and Interface are
+// required; the rest default to those of the CLI.
+type ManifestFake struct {
+	// Package is the import path of the package that declares Interface.
+	Package   string `json:"package"`
+	Interface string `json:"interface"`
+
+	// OutDir is the directory the fake is written to, relative to the
+	// manifest.
+	OutDir      string `json:"outDir,omitempty"`
+	Name        string `json:"name,omitempty"`
+	PackageName string `json:"packageName,omitempty"`
+
+	Strict       bool `json:"strict,omitempty"`
+	DeepCopyArgs bool `json:"deepCopyArgs,omitempty"`
+	IncludeTests bool `json:"includeTests,omitempty"`
+}
+
+// ReadManifest reads and checks the manifest at path.
+func ReadManifest(path string) (*Manifest, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(src))
+	decoder.DisallowUnknownFields()
+
+	var manifest Manifest
+	err = decoder.Decode(&manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+
+	for i, fake := range manifest.Fakes {
+		err := fake.check()
+		if err != nil {
+			return nil, fmt.Errorf("%s: fakes[%d]: %s", path, i, err)
+		}
+	}
+
+	return &manifest, nil
+}
+
+func (f ManifestFake) check() error {
+	if f.Package == "" {
+		return fmt.Errorf("package is required")
+	}
+
+	if f.Interface == "" {
+		return fmt.Errorf("interface is required")
+	}
+
+	if !token.IsIdentifier(f.Interface) {
+		return fmt.Errorf("interface %q is not a valid interface name", f.Interface)
+	}
+
+	if f.Name != "" && !token.IsIdentifier(f.Name) {
+		return fmt.Errorf("name %q is not a valid name", f.Name)
+	}
+
+	if f.PackageName != "" && !token.IsIdentifier(f.PackageName) {
+		return fmt.Errorf("packageName %q is not a valid package name", f.PackageName)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package margarine_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/krishicks/margarine"
+	"github.com/krishicks/margarine/internal/testfiles"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadManifest", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	var writeManifest = func(src string) {
+		err := os.WriteFile(path, []byte(src), 0644)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		dir = testfiles.TempDir()
+
+		path = filepath.Join(dir, margarine.ManifestFile)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Context("when the manifest is valid", func() {
+		BeforeEach(func() {
+			writeManifest(`{
+  "fakes": [
+    {
+      "package": "example.com/service/store",
+      "interface": "Store",
+      "outDir": "store/storefakes",
+      "name": "FakeStore",
+      "packageName": "storefakes",
+      "strict": true,
+      "deepCopyArgs": true,
+      "includeTests": true
+    },
+    {
+      "package": "io",
+      "interface": "Writer"
+    }
+  ]
+}`)
+		})
+
+		It("returns the fakes it lists", func() {
+			manifest, err := margarine.ReadManifest(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(manifest.Fakes).To(Equal([]margarine.ManifestFake{
+				{
+					Package:      "example.com/service/store",
+					Interface:    "Store",
+					OutDir:       "store/storefakes",
+					Name:         "FakeStore",
+					PackageName:  "storefakes",
+					Strict:       true,
+					DeepCopyArgs: true,
+					IncludeTests: true,
+				},
+				{
+					Package:   "io",
+					Interface: "Writer",
+				},
+			}))
+		})
+	})
+
+	Context("when the manifest has an unknown field", func() {
+		BeforeEach(func() {
+			writeManifest(`{"fakes": [{"package": "io", "interfac": "Writer"}]}`)
+		})
+
+		It("returns an error", func() {
+			_, err := margarine.ReadManifest(path)
+			Expect(err).To(MatchError(path + `: json: unknown field "interfac"`))
+		})
+	})
+
+	Context("when a fake has no package", func() {
+		BeforeEach(func() {
+			writeManifest(`{"fakes": [{"package": "io", "interface": "Writer"}, {"interface": "Reader"}]}`)
+		})
+
+		It("returns an error naming the fake", func() {
+			_, err := margarine.ReadManifest(path)
+			Expect(err).To(MatchError(path + ": fakes[1]: package is required"))
+		})
+	})
+
+	Context("when a fake has no interface", func() {
+		BeforeEach(func() {
+			writeManifest(`{"fakes": [{"package": "io"}]}`)
+		})
+
+		It("returns an error naming the fake", func() {
+			_, err := margarine.ReadManifest(path)
+			Expect(err).To(MatchError(path + ": fakes[0]: interface is required"))
+		})
+	})
+
+	Context("when a fake has an invalid name", func() {
+		BeforeEach(func() {
+			writeManifest(`{"fakes": [{"package": "io", "interface": "Writer", "name": "Fake-Writer"}]}`)
+		})
+
+		It("returns an error naming the fake", func() {
+			_, err := margarine.ReadManifest(path)
+			Expect(err).To(MatchError(path + `: fakes[0]: name "Fake-Writer" is not a valid name`))
+		})
+	})
+})
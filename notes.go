@@ -6,4 +6,5 @@ type Simple interface {
 	A(a, b int, c string, d int) (e, y int)
 	B(int, int, int) os.Signal
 	C(int, string, int)
+	D(_, _ int, s string) (_ bool, err error)
 }
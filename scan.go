@@ -0,0 +1,191 @@
+package margarine
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const directivePrefix = "//margarine:"
+
+// Directive is a //margarine:fake directive in the doc comment of an
+// interface, which asks for a fake of it:
+//
+//	//margarine:fake name=FakeStore out=storefakes/store.go strict
+//	type Store interface {
+//
+// The options are:
+//
+//	name=<name>        the name of the fake
+//	out=<file>         the file to write the fake to, relative to Dir
+//	package=<name>     the package of the fake
+//	strict             fail calls without a stub or return values
+//	deep-copy-args     record deep copies of the args
+type Directive struct {
+	Pos token.Position
+
+	// Dir is the directory of the package that declares Interface.
+	Dir       string
+	Interface string
+
+	Name         string
+	Out          string
+	Package      string
+	Strict       bool
+	DeepCopyArgs bool
+}
+
+// Scan walks the directories under root and returns the directives in the
+// doc comments of the interfaces declared there, in the order of their
+// files. Like the go tool, it skips vendor and testdata directories and
+// those whose names start with . or _.
+func Scan(fset *token.FileSet, root string) ([]Directive, error) {
+	var directives []Directive
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+
+		name := entry.Name()
+		if path != root && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+			return filepath.SkipDir
+		}
+
+		found, err := scanDir(fset, path)
+		if err != nil {
+			return err
+		}
+		directives = append(directives, found...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return directives, nil
+}
+
+func scanDir(fset *token.FileSet, dir string) ([]Directive, error) {
+	filenames, err := goFiles(dir, FindOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	var directives []Directive
+	for _, filename := range filenames {
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		// most files have no directives, and don't need parsing
+		if !bytes.Contains(src, []byte(directivePrefix)) {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		found, err := fileDirectives(fset, dir, file)
+		if err != nil {
+			return nil, err
+		}
+		directives = append(directives, found...)
+	}
+
+	return directives, nil
+}
+
+func fileDirectives(fset *token.FileSet, dir string, file *ast.File) ([]Directive, error) {
+	var directives []Directive
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+
+			// the doc comment of an ungrouped declaration belongs to the
+			// declaration rather than its spec
+			doc := typeSpec.Doc
+			if genDecl.Lparen == token.NoPos {
+				doc = genDecl.Doc
+			}
+			if doc == nil {
+				continue
+			}
+
+			for _, comment := range doc.List {
+				if !strings.HasPrefix(comment.Text, directivePrefix) {
+					continue
+				}
+
+				directive, err := parseDirective(comment.Text)
+				if err == nil {
+					if _, ok := typeSpec.Type.(*ast.InterfaceType); !ok {
+						err = &NotAnInterfaceError{Name: typeSpec.Name.Name}
+					}
+				}
+				if err != nil {
+					return nil, &Error{
+						Pos:       fset.Position(comment.Pos()),
+						Interface: typeSpec.Name.Name,
+						Err:       err,
+					}
+				}
+
+				directive.Pos = fset.Position(comment.Pos())
+				directive.Dir = dir
+				directive.Interface = typeSpec.Name.Name
+				directives = append(directives, directive)
+			}
+		}
+	}
+
+	return directives, nil
+}
+
+func parseDirective(text string) (Directive, error) {
+	var directive Directive
+
+	fields := strings.Fields(strings.TrimPrefix(text, directivePrefix))
+	if len(fields) == 0 || fields[0] != "fake" {
+		return directive, fmt.Errorf("unknown directive %s", strings.SplitN(text, " ", 2)[0])
+	}
+
+	for _, option := range fields[1:] {
+		key, value, hasValue := strings.Cut(option, "=")
+
+		switch {
+		case key == "name" && hasValue && token.IsIdentifier(value):
+			directive.Name = value
+		case key == "out" && hasValue && value != "":
+			directive.Out = filepath.FromSlash(value)
+		case key == "package" && hasValue && token.IsIdentifier(value):
+			directive.Package = value
+		case key == "strict" && !hasValue:
+			directive.Strict = true
+		case key == "deep-copy-args" && !hasValue:
+			directive.DeepCopyArgs = true
+		default:
+			return directive, fmt.Errorf("invalid option %s in margarine:fake directive", option)
+		}
+	}
+
+	return directive, nil
+}
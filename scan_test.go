@@ -0,0 +1,118 @@
+package margarine_test
+
+import (
+	"errors"
+	"go/token"
+	"os"
+	"path/filepath"
+
+	"github.com/krishicks/margarine"
+	"github.com/krishicks/margarine/internal/testfiles"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scan", func() {
+	var (
+		fset *token.FileSet
+		root string
+	)
+
+	BeforeEach(func() {
+		fset = token.NewFileSet()
+
+		root = testfiles.TempDir()
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(root)
+	})
+
+	Context("when interfaces have directives", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(root, "a.go", `package mypackage
+
+//margarine:fake
+type Store interface{}
+
+// Cache is documented.
+//
+//margarine:fake name=MockCache out=mocks/cache.go package=mocks strict deep-copy-args
+type Cache interface{}
+
+type (
+	//margarine:fake
+	Grouped interface{}
+
+	Plain interface{}
+)
+`)
+			testfiles.WriteFile(root, "sub/b.go", "package sub\n\n//margarine:fake\ntype B interface{}\n")
+			testfiles.WriteFile(root, "a_test.go", "package mypackage\n\n//margarine:fake\ntype InTest interface{}\n")
+			testfiles.WriteFile(root, "testdata/c.go", "package c\n\n//margarine:fake\ntype C interface{}\n")
+			testfiles.WriteFile(root, "vendor/d/d.go", "package d\n\n//margarine:fake\ntype D interface{}\n")
+			testfiles.WriteFile(root, ".hidden/e.go", "package e\n\n//margarine:fake\ntype E interface{}\n")
+		})
+
+		It("returns them with their options", func() {
+			directives, err := margarine.Scan(fset, root)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(directives).To(HaveLen(4))
+
+			Expect(directives[0].Interface).To(Equal("Store"))
+			Expect(directives[0].Dir).To(Equal(root))
+			Expect(directives[0].Pos.Line).To(Equal(3))
+
+			Expect(directives[1]).To(Equal(margarine.Directive{
+				Pos:          directives[1].Pos,
+				Dir:          root,
+				Interface:    "Cache",
+				Name:         "MockCache",
+				Out:          filepath.Join("mocks", "cache.go"),
+				Package:      "mocks",
+				Strict:       true,
+				DeepCopyArgs: true,
+			}))
+
+			Expect(directives[2].Interface).To(Equal("Grouped"))
+
+			Expect(directives[3].Interface).To(Equal("B"))
+			Expect(directives[3].Dir).To(Equal(filepath.Join(root, "sub")))
+		})
+	})
+
+	Context("when a directive has an invalid option", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(root, "a.go", "package mypackage\n\n//margarine:fake nmae=Store\ntype Store interface{}\n")
+		})
+
+		It("returns an error with the position of the directive", func() {
+			_, err := margarine.Scan(fset, root)
+			Expect(err).To(MatchError(filepath.Join(root, "a.go") + ":3:1: Store: invalid option nmae=Store in margarine:fake directive"))
+		})
+	})
+
+	Context("when a directive is unknown", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(root, "a.go", "package mypackage\n\n//margarine:faker\ntype Store interface{}\n")
+		})
+
+		It("returns an error", func() {
+			_, err := margarine.Scan(fset, root)
+			Expect(err).To(MatchError(filepath.Join(root, "a.go") + ":3:1: Store: unknown directive //margarine:faker"))
+		})
+	})
+
+	Context("when a directive is on a type that is not an interface", func() {
+		BeforeEach(func() {
+			testfiles.WriteFile(root, "a.go", "package mypackage\n\n//margarine:fake\ntype Store struct{}\n")
+		})
+
+		It("returns a NotAnInterfaceError", func() {
+			_, err := margarine.Scan(fset, root)
+
+			var notAnInterfaceErr *margarine.NotAnInterfaceError
+			Expect(errors.As(err, &notAnInterfaceErr)).To(BeTrue())
+		})
+	})
+})